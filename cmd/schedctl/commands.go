@@ -0,0 +1,562 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler"
+	"github.com/sidchai/compkg/pkg/scheduler/cron"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// commands 全部子命令；新增命令在此登记即可出现在 usage 中。
+var commands = []command{
+	{group: "jobs", name: "list", help: "列出 job", setup: jobsList},
+	{group: "jobs", name: "get", args: "<job_name>", nargs: 1, help: "查看 job 详情", setup: jobsGet},
+	{group: "jobs", name: "pause", args: "<job_name>", nargs: 1, help: "暂停 job 定时触发", setup: jobsPause},
+	{group: "jobs", name: "resume", args: "<job_name>", nargs: 1, help: "恢复已暂停的 job", setup: jobsResume},
+	{group: "jobs", name: "trigger", args: "<job_name>", nargs: 1, help: "手动触发一次 job", setup: jobsTrigger},
+	{group: "jobs", name: "sync", args: "<jobs.yaml>", nargs: 1, help: "按 YAML 声明同步 job（支持 --dry-run / --prune）", setup: jobsSync},
+	{group: "jobs", name: "validate", args: "<jobs.yaml>", nargs: 1, offline: true, help: "本地校验 YAML 并预览 cron job 的触发时间", setup: jobsValidate},
+
+	{group: "runs", name: "list", help: "列出 run（支持 --since 24h）", setup: runsList},
+	{group: "runs", name: "get", args: "<run_id>", nargs: 1, help: "查看 run 详情", setup: runsGet},
+	{group: "runs", name: "retry", args: "<run_id>", nargs: 1, help: "以原参数重跑 run", setup: runsRetry},
+	{group: "runs", name: "cancel", args: "<run_id>", nargs: 1, help: "取消未结束的 run", setup: runsCancel},
+
+	{group: "workers", name: "list", help: "列出 worker", setup: workersList},
+	{group: "workers", name: "kick", args: "<worker_id>", nargs: 1, help: "强制断开 worker", setup: workersKick},
+
+	{group: "apps", name: "create", args: "<app_name>", nargs: 1, help: "注册应用（app_secret 仅显示一次）", setup: appsCreate},
+	{group: "apps", name: "reset-secret", args: "<app_name>", nargs: 1, help: "重置应用 app_secret（需 --yes）", setup: appsResetSecret},
+
+	{group: "changes", name: "list", help: "列出待复核变更", setup: changesList},
+	{group: "changes", name: "approve", args: "<id>", nargs: 1, help: "通过待复核变更", setup: changesApprove},
+	{group: "changes", name: "reject", args: "<id>", nargs: 1, help: "驳回待复核变更", setup: changesReject},
+
+	{group: "cron", name: "next", args: "<cron_expr>", nargs: 1, offline: true, help: "校验 cron 表达式并列出之后的触发时间（支持 --tz / -n）", setup: cronNext},
+}
+
+// defaultOperator 审计用操作人，默认取 $USER。
+func defaultOperator() string {
+	return os.Getenv("USER")
+}
+
+// ===== jobs =====
+
+var jobHeader = []string{"NAME", "APP", "TRIGGER", "SCHEDULE", "STATUS", "PRIORITY", "CRITICAL", "NEXT_RUN"}
+
+func jobRow(j *pb.Job) []string {
+	return []string{
+		j.JobName, j.AppName,
+		trimEnum(j.TriggerType.String(), "TRIGGER_TYPE_"), jobSchedule(j),
+		emptyAs(j.Status, "-"), trimEnum(j.Priority.String(), "PRIORITY_"),
+		fmtBool(j.Critical), fmtUnix(j.NextRunAt),
+	}
+}
+
+// jobSchedule 按触发类型取可读的调度描述。
+func jobSchedule(j *pb.Job) string {
+	switch j.TriggerType {
+	case pb.TriggerType_TRIGGER_TYPE_CRON:
+		if j.Timezone != "" {
+			return j.CronExpr + " (" + j.Timezone + ")"
+		}
+		return j.CronExpr
+	case pb.TriggerType_TRIGGER_TYPE_FIXED_RATE:
+		return "every " + (time.Duration(j.FixedRateSeconds) * time.Second).String()
+	case pb.TriggerType_TRIGGER_TYPE_ONE_TIME:
+		return "at " + fmtUnix(j.OneTimeAt)
+	default:
+		return "-"
+	}
+}
+
+func jobFields(j *pb.Job) []field {
+	return []field{
+		{"Name", j.JobName},
+		{"App", j.AppName},
+		{"Description", emptyAs(j.Description, "-")},
+		{"Trigger", trimEnum(j.TriggerType.String(), "TRIGGER_TYPE_")},
+		{"Schedule", jobSchedule(j)},
+		{"Misfire", emptyAs(j.MisfirePolicy, "-")},
+		{"ExecuteMode", trimEnum(j.ExecuteMode.String(), "EXECUTE_MODE_")},
+		{"ShardTotal", strconv.Itoa(int(j.ShardTotal))},
+		{"Timeout", (time.Duration(j.TimeoutSeconds) * time.Second).String()},
+		{"MaxInflight", strconv.Itoa(int(j.MaxInflight))},
+		{"RetryMax", strconv.Itoa(int(j.RetryMax))},
+		{"RetryBackoff", emptyAs(j.RetryBackoff, "-")},
+		{"Enabled", fmtBool(j.Enabled)},
+		{"Status", emptyAs(j.Status, "-")},
+		{"Priority", trimEnum(j.Priority.String(), "PRIORITY_")},
+		{"Critical", fmtBool(j.Critical)},
+		{"SuccessRate30d", fmt.Sprintf("%.2f%%", j.SuccessRate_30D*100)},
+		{"NextRun", fmtUnix(j.NextRunAt)},
+		{"UpdatedBy", emptyAs(j.UpdatedBy, "-")},
+		{"UpdatedAt", fmtUnix(j.UpdatedAt)},
+	}
+}
+
+func jobsList(fs *flag.FlagSet) runner {
+	var f scheduler.JobFilter
+	fs.StringVar(&f.AppName, "app", "", "按应用过滤")
+	fs.StringVar(&f.Keyword, "keyword", "", "按名称 / 描述关键字过滤")
+	fs.StringVar(&f.Status, "status", "", "按状态过滤：waiting_worker / ready / paused / disabled")
+	limit := fs.Int("limit", 50, "最多输出条数，0 表示全部")
+	return func(ctx context.Context, e *env, _ []string) error {
+		f.PageSize = pageSizeFor(*limit)
+		var jobs []*pb.Job
+		for j, err := range e.admin.Jobs(ctx, f) {
+			if err != nil {
+				return err
+			}
+			jobs = append(jobs, j)
+			if *limit > 0 && len(jobs) >= *limit {
+				break
+			}
+		}
+		return printMessages(e.out, jobs, jobHeader, jobRow)
+	}
+}
+
+func jobsGet(*flag.FlagSet) runner {
+	return func(ctx context.Context, e *env, args []string) error {
+		j, err := e.admin.GetJob(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return printMessage(e.out, j, jobFields(j))
+	}
+}
+
+func jobsPause(*flag.FlagSet) runner {
+	return func(ctx context.Context, e *env, args []string) error {
+		j, err := e.admin.PauseJob(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return printMessages(e.out, []*pb.Job{j}, jobHeader, jobRow)
+	}
+}
+
+func jobsResume(*flag.FlagSet) runner {
+	return func(ctx context.Context, e *env, args []string) error {
+		j, err := e.admin.ResumeJob(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return printMessages(e.out, []*pb.Job{j}, jobHeader, jobRow)
+	}
+}
+
+func jobsTrigger(fs *flag.FlagSet) runner {
+	var opts scheduler.TriggerOptions
+	fs.StringVar(&opts.BizKey, "biz-key", "", "业务幂等键")
+	payload := fs.String("payload", "", "业务负载（原样作为 payload 字节）")
+	fs.StringVar(&opts.Operator, "operator", defaultOperator(), "审计用操作人")
+	return func(ctx context.Context, e *env, args []string) error {
+		if *payload != "" {
+			opts.Payload = []byte(*payload)
+		}
+		runID, err := e.admin.TriggerJob(ctx, args[0], opts)
+		if err != nil {
+			return err
+		}
+		return printFields(e.out, []field{{"run_id", runID}})
+	}
+}
+
+func jobsSync(fs *flag.FlagSet) runner {
+	var opts scheduler.SyncOptions
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "只打印同步计划，不做修改")
+	fs.BoolVar(&opts.Prune, "prune", false, "删除 YAML 中不存在的本应用 job（critical job 除外）")
+	fs.IntVar(&opts.Preview, "preview", 3, "计划中列出新建 / 调整触发配置的 cron job 之后的触发次数，0 表示不列出")
+	return func(ctx context.Context, e *env, args []string) error {
+		defs, err := scheduler.LoadJobDefs(args[0])
+		if err != nil {
+			return err
+		}
+		// 计划统一由下方输出，dry-run 时丢弃 SyncJobs 自带的打印
+		opts.Output = io.Discard
+		plan, syncErr := e.admin.SyncJobs(ctx, defs, opts)
+		if plan == nil {
+			return syncErr
+		}
+		if e.out.json {
+			changes := append([]scheduler.JobChange{}, plan.Changes...)
+			if err := e.out.writeJSON(changes); err != nil {
+				return err
+			}
+		} else if _, err := io.WriteString(e.out.w, plan.String()); err != nil {
+			return err
+		}
+		return syncErr
+	}
+}
+
+var jobPreviewHeader = []string{"NAME", "TRIGGER", "SCHEDULE", "NEXT_RUNS"}
+
+// jobPreview jobs validate 的一行；json 输出同名字段。
+type jobPreview struct {
+	Name     string      `json:"name"`
+	Trigger  string      `json:"trigger"`
+	Schedule string      `json:"schedule"`
+	NextRuns []time.Time `json:"next_runs,omitempty"`
+}
+
+func jobsValidate(fs *flag.FlagSet) runner {
+	n := fs.Int("n", 3, "每个 cron job 预览的触发次数")
+	return func(_ context.Context, e *env, args []string) error {
+		defs, err := scheduler.LoadJobDefs(args[0])
+		if err != nil {
+			return err
+		}
+		previews := make([]jobPreview, 0, len(defs))
+		for _, d := range defs {
+			p := jobPreview{Name: d.Name, Trigger: "API", Schedule: "-"}
+			switch {
+			case d.CronExpr != "":
+				p.Trigger = "CRON"
+				p.Schedule = jobSchedule(&pb.Job{TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON, CronExpr: d.CronExpr, Timezone: d.Timezone})
+				s, err := cron.ParseInZone(d.CronExpr, d.Timezone) // LoadJobDefs 已校验
+				if err != nil {
+					return err
+				}
+				p.NextRuns = s.NextN(e.now(), *n)
+			case d.FixedRate != "":
+				p.Trigger, p.Schedule = "FIXED_RATE", "every "+d.FixedRate
+			}
+			previews = append(previews, p)
+		}
+		if e.out.json {
+			return e.out.writeJSON(previews)
+		}
+		rows := make([][]string, 0, len(previews))
+		for _, p := range previews {
+			rows = append(rows, []string{p.Name, p.Trigger, p.Schedule, emptyAs(fmtTimes(p.NextRuns), "-")})
+		}
+		return writeTable(e.out.w, jobPreviewHeader, rows)
+	}
+}
+
+// ===== cron =====
+
+func cronNext(fs *flag.FlagSet) runner {
+	tz := fs.String("tz", "", "时区，如 Asia/Shanghai（默认本地时区）")
+	n := fs.Int("n", 5, "列出的触发次数")
+	from := fs.String("from", "", "起算时间：unix 秒、日期或本地时间（默认当前时间）")
+	return func(_ context.Context, e *env, args []string) error {
+		s, err := cron.ParseInZone(args[0], *tz)
+		if err != nil {
+			return err
+		}
+		start := e.now()
+		if *from != "" {
+			if start, err = parseTimeArg(*from, start); err != nil {
+				return err
+			}
+		}
+		times := s.NextN(start, *n)
+		if e.out.json {
+			return e.out.writeJSON(times)
+		}
+		rows := make([][]string, 0, len(times))
+		for _, t := range times {
+			rows = append(rows, []string{t.Format(cronTimeLayout), t.Weekday().String()[:3]})
+		}
+		return writeTable(e.out.w, []string{"NEXT_RUN", "WEEKDAY"}, rows)
+	}
+}
+
+// ===== runs =====
+
+var runHeader = []string{"RUN_ID", "JOB", "STATUS", "TRIGGER", "BIZ_KEY", "WORKER", "RETRY", "DURATION", "CREATED"}
+
+func runRow(r *pb.Run) []string {
+	return []string{
+		r.RunId, r.JobName,
+		trimEnum(r.Status.String(), "RUN_STATUS_"), trimEnum(r.TriggerType.String(), "TRIGGER_TYPE_"),
+		emptyAs(r.BizKey, "-"), emptyAs(r.WorkerId, "-"),
+		strconv.Itoa(int(r.RetryCount)), fmtDurationMs(r.DurationMs), fmtUnix(r.CreatedAt),
+	}
+}
+
+func runFields(r *pb.Run) []field {
+	return []field{
+		{"RunID", r.RunId},
+		{"Job", r.JobName},
+		{"App", r.AppName},
+		{"Status", trimEnum(r.Status.String(), "RUN_STATUS_")},
+		{"Trigger", trimEnum(r.TriggerType.String(), "TRIGGER_TYPE_")},
+		{"TriggerSource", emptyAs(r.TriggerSource, "-")},
+		{"BizKey", emptyAs(r.BizKey, "-")},
+		{"Worker", emptyAs(r.WorkerId, "-")},
+		{"Shard", fmt.Sprintf("%d/%d", r.ShardIndex, r.ShardTotal)},
+		{"Retry", strconv.Itoa(int(r.RetryCount))},
+		{"ParentRunID", emptyAs(r.ParentRunId, "-")},
+		{"NextRun", fmtUnix(r.NextRunAt)},
+		{"Priority", trimEnum(r.Priority.String(), "PRIORITY_")},
+		{"Progress", fmtProgress(r)},
+		{"Duration", fmtDurationMs(r.DurationMs)},
+		{"Created", fmtUnix(r.CreatedAt)},
+		{"Dispatched", fmtUnixMilli(r.DispatchedAt)},
+		{"Started", fmtUnixMilli(r.StartedAt)},
+		{"Ended", fmtUnixMilli(r.EndedAt)},
+		{"TraceID", emptyAs(r.TraceId, "-")},
+		{"Error", emptyAs(r.Error, "-")},
+		{"Output", emptyAs(string(r.Output), "-")},
+	}
+}
+
+// fmtProgress 最近一次进度："45% exporting 4500/10000 (2026-01-02 15:04:05)"；未上报过为 "-"。
+func fmtProgress(r *pb.Run) string {
+	if r.ProgressAt == 0 {
+		return "-"
+	}
+	s := fmt.Sprintf("%d%%", r.ProgressPercent)
+	if r.ProgressMessage != "" {
+		s += " " + r.ProgressMessage
+	}
+	return s + " (" + fmtUnixMilli(r.ProgressAt) + ")"
+}
+
+func runsList(fs *flag.FlagSet) runner {
+	var f scheduler.RunFilter
+	fs.StringVar(&f.JobName, "job", "", "按 job 过滤")
+	fs.StringVar(&f.AppName, "app", "", "按应用过滤")
+	fs.StringVar(&f.BizKeyLike, "biz-key", "", "按 biz_key 模糊匹配")
+	fs.StringVar(&f.WorkerID, "worker", "", "按 worker 过滤")
+	fs.StringVar(&f.ParentRunID, "parent", "", "列出该父 run 下的分片 run")
+	status := fs.String("status", "", "按状态过滤：pending / running / success / failed / timeout / dead / canceled ...")
+	since := fs.String("since", "", "起始时间：相对时长（24h / 30m）、unix 秒、2006-01-02 或 2006-01-02 15:04:05")
+	until := fs.String("until", "", "截止时间，格式同 --since")
+	limit := fs.Int("limit", 50, "最多输出条数，0 表示全部")
+	return func(ctx context.Context, e *env, _ []string) error {
+		var err error
+		if f.Status, err = parseRunStatus(*status); err != nil {
+			return err
+		}
+		if f.Since, err = parseTimeArg(*since, e.now()); err != nil {
+			return fmt.Errorf("--since: %w", err)
+		}
+		if f.Until, err = parseTimeArg(*until, e.now()); err != nil {
+			return fmt.Errorf("--until: %w", err)
+		}
+		f.PageSize = pageSizeFor(*limit)
+		var runs []*pb.Run
+		for r, err := range e.admin.Runs(ctx, f) {
+			if err != nil {
+				return err
+			}
+			runs = append(runs, r)
+			if *limit > 0 && len(runs) >= *limit {
+				break
+			}
+		}
+		return printMessages(e.out, runs, runHeader, runRow)
+	}
+}
+
+func runsGet(*flag.FlagSet) runner {
+	return func(ctx context.Context, e *env, args []string) error {
+		r, err := e.admin.GetRun(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return printMessage(e.out, r, runFields(r))
+	}
+}
+
+func runsRetry(*flag.FlagSet) runner {
+	return func(ctx context.Context, e *env, args []string) error {
+		newID, err := e.admin.RetryRun(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return printFields(e.out, []field{{"run_id", args[0]}, {"new_run_id", newID}})
+	}
+}
+
+func runsCancel(fs *flag.FlagSet) runner {
+	reason := fs.String("reason", "canceled by schedctl", "取消原因")
+	return func(ctx context.Context, e *env, args []string) error {
+		if err := e.admin.CancelRun(ctx, args[0], *reason); err != nil {
+			return err
+		}
+		return printFields(e.out, []field{{"run_id", args[0]}, {"canceled", "true"}})
+	}
+}
+
+// ===== workers =====
+
+func workersList(fs *flag.FlagSet) runner {
+	app := fs.String("app", "", "按应用过滤")
+	status := fs.String("status", "", "按状态过滤：online / draining / offline")
+	return func(ctx context.Context, e *env, _ []string) error {
+		workers, err := e.admin.ListWorkers(ctx, *app, *status)
+		if err != nil {
+			return err
+		}
+		header := []string{"WORKER_ID", "APP", "IP", "SDK", "STATUS", "INFLIGHT", "HANDLERS", "LAST_HEARTBEAT"}
+		return printMessages(e.out, workers, header, func(w *pb.Worker) []string {
+			return []string{
+				w.WorkerId, w.AppName, emptyAs(w.Ip, "-"), emptyAs(w.SdkVersion, "-"), w.Status,
+				strconv.Itoa(int(w.Inflight)), strconv.Itoa(len(w.HandlerJobs)), fmtUnix(w.LastHeartbeat),
+			}
+		})
+	}
+}
+
+func workersKick(fs *flag.FlagSet) runner {
+	reason := fs.String("reason", "kicked by schedctl", "断开原因")
+	return func(ctx context.Context, e *env, args []string) error {
+		if err := e.admin.KickWorker(ctx, args[0], *reason); err != nil {
+			return err
+		}
+		return printFields(e.out, []field{{"worker_id", args[0]}, {"kicked", "true"}})
+	}
+}
+
+// ===== apps =====
+
+func appsCreate(fs *flag.FlagSet) runner {
+	req := &pb.CreateAppRequest{}
+	fs.StringVar(&req.Owner, "owner", defaultOperator(), "负责人")
+	fs.StringVar(&req.Description, "desc", "", "描述")
+	qps := fs.Int("qps", 0, "SubmitTask QPS 配额，0 使用服务端默认")
+	payloadMax := fs.Int("payload-max", 0, "payload 最大字节数，0 使用服务端默认")
+	fs.StringVar(&req.WebhookUrl, "webhook", "", "应用级告警 webhook")
+	return func(ctx context.Context, e *env, args []string) error {
+		req.AppName = args[0]
+		req.QpsQuota = int32(*qps)
+		req.PayloadMaxBytes = int32(*payloadMax)
+		app, secret, err := e.admin.CreateApp(ctx, req)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(e.stderr, "注意：app_secret 仅显示这一次，请立即保存到密钥管理系统")
+		return printFields(e.out, []field{{"app_name", app.GetAppName()}, {"app_key", app.GetAppKey()}, {"app_secret", secret}})
+	}
+}
+
+func appsResetSecret(fs *flag.FlagSet) runner {
+	yes := fs.Bool("yes", false, "确认重置：旧 secret 立即失效，在线 worker 需用新 secret 重连")
+	return func(ctx context.Context, e *env, args []string) error {
+		if !*yes {
+			return fmt.Errorf("%w: 重置后旧 app_secret 立即失效，确认请加 --yes", errUsage)
+		}
+		secret, err := e.admin.ResetAppSecret(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(e.stderr, "注意：app_secret 仅显示这一次，请立即保存到密钥管理系统")
+		return printFields(e.out, []field{{"app_name", args[0]}, {"app_secret", secret}})
+	}
+}
+
+// ===== changes =====
+
+func changesList(fs *flag.FlagSet) runner {
+	status := fs.String("status", "pending", "按状态过滤，空表示全部")
+	return func(ctx context.Context, e *env, _ []string) error {
+		items, err := e.admin.ListPendingChanges(ctx, *status)
+		if err != nil {
+			return err
+		}
+		header := []string{"ID", "TARGET", "PROPOSER", "STATUS", "PROPOSED", "EXPIRES"}
+		return printMessages(e.out, items, header, func(c *pb.PendingChange) []string {
+			return []string{
+				strconv.FormatInt(c.Id, 10), c.TargetType + "/" + c.TargetId, c.Proposer, c.Status,
+				fmtUnix(c.ProposedAt), fmtUnix(c.ExpiresAt),
+			}
+		})
+	}
+}
+
+func changesApprove(fs *flag.FlagSet) runner {
+	approver := fs.String("approver", defaultOperator(), "复核人，不能与提交人相同")
+	return func(ctx context.Context, e *env, args []string) error {
+		id, err := parseChangeID(args[0])
+		if err != nil {
+			return err
+		}
+		if err := e.admin.ApprovePendingChange(ctx, id, *approver); err != nil {
+			return err
+		}
+		return printFields(e.out, []field{{"id", args[0]}, {"status", "approved"}})
+	}
+}
+
+func changesReject(fs *flag.FlagSet) runner {
+	approver := fs.String("approver", defaultOperator(), "复核人")
+	reason := fs.String("reason", "", "驳回原因")
+	return func(ctx context.Context, e *env, args []string) error {
+		id, err := parseChangeID(args[0])
+		if err != nil {
+			return err
+		}
+		if err := e.admin.RejectPendingChange(ctx, id, *approver, *reason); err != nil {
+			return err
+		}
+		return printFields(e.out, []field{{"id", args[0]}, {"status", "rejected"}})
+	}
+}
+
+// ===== 参数解析 =====
+
+// pageSizeFor 按 --limit 选择分页大小，避免为少量结果拉满一页。
+func pageSizeFor(limit int) int32 {
+	if limit > 0 && limit < 100 {
+		return int32(limit)
+	}
+	return 100
+}
+
+func parseChangeID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("%w: 变更 id 须为正整数，当前 %q", errUsage, s)
+	}
+	return id, nil
+}
+
+// parseRunStatus 把 failed / FAILED / RUN_STATUS_FAILED 解析为 RunStatus；空串表示不过滤。
+func parseRunStatus(s string) (pb.RunStatus, error) {
+	if s == "" {
+		return pb.RunStatus_RUN_STATUS_UNSPECIFIED, nil
+	}
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if !strings.HasPrefix(name, "RUN_STATUS_") {
+		name = "RUN_STATUS_" + name
+	}
+	v, ok := pb.RunStatus_value[name]
+	if !ok {
+		return 0, fmt.Errorf("%w: 未知的 run 状态 %q", errUsage, s)
+	}
+	return pb.RunStatus(v), nil
+}
+
+// parseTimeArg 解析 --since / --until：相对时长（相对 now 往前）、unix 秒、日期或本地时间；空串返回零值。
+func parseTimeArg(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(sec, 0), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: 无法解析时间 %q", errUsage, s)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// 环境变量：优先级高于配置文件，便于 CI / 值班机临时覆盖。
+const (
+	envConfig     = "SCHEDCTL_CONFIG"
+	envEndpoint   = "SCHED_ENDPOINT"
+	envAppName    = "SCHED_APP_NAME"
+	envAppKey     = "SCHED_APP_KEY"
+	envAppSecret  = "SCHED_APP_SECRET"
+	envAdminToken = "SCHED_ADMIN_TOKEN"
+)
+
+// defaultConfigName 未指定 -config 且未设置 SCHEDCTL_CONFIG 时，尝试读取 $HOME 下的该文件。
+const defaultConfigName = ".schedctl.yaml"
+
+// CtlConfig schedctl 的连接配置。
+type CtlConfig struct {
+	Endpoint   string `yaml:"endpoint"`   // scheduler gRPC 地址，如 scheduler:9090；多副本用逗号分隔
+	AppName    string `yaml:"appName"`    // 调用方应用名
+	AppKey     string `yaml:"appKey"`     // 应用 app_key
+	AppSecret  string `yaml:"appSecret"`  // 应用 app_secret；建议用 SCHED_APP_SECRET 注入，不落盘
+	AdminToken string `yaml:"adminToken"` // 可选，管理面 JWT（scheduler UI 登录获得）；建议用 SCHED_ADMIN_TOKEN 注入，不落盘
+	Timeout    string `yaml:"timeout"`    // 单次 RPC 超时，如 "10s"，默认 10s
+	Output     string `yaml:"output"`     // 默认输出格式 table/json，默认 table
+
+	timeout time.Duration `yaml:"-"` // 解析后的超时（内部使用）
+}
+
+// TimeoutDuration 返回解析后的 RPC 超时。
+func (c *CtlConfig) TimeoutDuration() time.Duration { return c.timeout }
+
+// resolveConfigPath 按 -config → SCHEDCTL_CONFIG → ~/.schedctl.yaml 的顺序确定配置文件；都没有时返回空串（仅用环境变量）。
+func resolveConfigPath(flagPath string) string {
+	if flagPath != "" {
+		return flagPath
+	}
+	if p := os.Getenv(envConfig); p != "" {
+		return p
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		p := filepath.Join(home, defaultConfigName)
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	return ""
+}
+
+// LoadConfig 读取配置文件（path 为空时跳过）→ 环境变量覆盖 → 校验。
+func LoadConfig(path string) (*CtlConfig, error) {
+	cfg := &CtlConfig{}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("读取配置文件失败: %w", err)
+		}
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("解析配置文件失败: %w", err)
+		}
+	}
+	for env, dst := range map[string]*string{
+		envEndpoint:   &cfg.Endpoint,
+		envAppName:    &cfg.AppName,
+		envAppKey:     &cfg.AppKey,
+		envAppSecret:  &cfg.AppSecret,
+		envAdminToken: &cfg.AdminToken,
+	} {
+		if v := os.Getenv(env); v != "" {
+			*dst = v
+		}
+	}
+
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint 不能为空（配置文件 endpoint 或环境变量 %s）", envEndpoint)
+	}
+	if cfg.AppName == "" || cfg.AppKey == "" || cfg.AppSecret == "" {
+		return nil, errors.New("appName / appKey / appSecret 不能为空（配置文件或 SCHED_APP_NAME / SCHED_APP_KEY / SCHED_APP_SECRET）")
+	}
+	if cfg.Timeout == "" {
+		cfg.timeout = 10 * time.Second
+	} else {
+		d, err := time.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("timeout 格式错误 %q: %w", cfg.Timeout, err)
+		}
+		cfg.timeout = d
+	}
+	if cfg.Output == "" {
+		cfg.Output = outputTable
+	}
+	if cfg.Output != outputTable && cfg.Output != outputJSON {
+		return nil, fmt.Errorf("output 只支持 %s / %s，当前 %q", outputTable, outputJSON, cfg.Output)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,192 @@
+// schedctl 是 iot-scheduler 的命令行运维工具，基于 scheduler.AdminClient 操作 job / run / worker / app / 双人复核。
+//
+// 用法: schedctl [-config schedctl.yaml] [-o table|json] <资源> <动作> [参数]
+//
+// 连接配置读取顺序：-config → 环境变量 SCHEDCTL_CONFIG → ~/.schedctl.yaml；
+// SCHED_ENDPOINT / SCHED_APP_NAME / SCHED_APP_KEY / SCHED_APP_SECRET / SCHED_ADMIN_TOKEN 覆盖配置文件中的同名字段。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler"
+)
+
+// errUsage 参数错误；main 以退出码 2 结束并打印用法。
+var errUsage = errors.New("参数错误")
+
+// env 命令执行上下文。
+type env struct {
+	admin  *scheduler.AdminClient // offline 命令为 nil
+	out    *printer
+	stderr io.Writer
+	now    func() time.Time
+}
+
+// runner 命令主体；args 为去掉 flag 后的位置参数。
+type runner func(ctx context.Context, e *env, args []string) error
+
+// command 一条 "<资源> <动作>" 子命令。
+type command struct {
+	group string
+	name  string
+	args  string // 位置参数说明，用于 usage
+	nargs int    // 位置参数个数
+	help  string
+	// offline 不连接 scheduler（e.admin 为 nil），如本地校验；无需配置文件
+	offline bool
+	// setup 注册该命令的 flag，返回命令主体
+	setup func(fs *flag.FlagSet) runner
+}
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run 解析参数 → 加载配置 → 拨号 → 执行子命令；返回进程退出码（0 成功 / 1 执行失败 / 2 参数错误）。
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	var configPath, output string
+	global := flag.NewFlagSet("schedctl", flag.ContinueOnError)
+	global.SetOutput(stderr)
+	registerGlobalFlags(global, &configPath, &output)
+	global.Usage = func() { printUsage(stderr) }
+	if err := global.Parse(args); err != nil {
+		return 2
+	}
+	rest := global.Args()
+	if len(rest) < 2 {
+		printUsage(stderr)
+		return 2
+	}
+	cmd := lookupCommand(rest[0], rest[1])
+	if cmd == nil {
+		fmt.Fprintf(stderr, "未知命令: %s %s\n\n", rest[0], rest[1])
+		printUsage(stderr)
+		return 2
+	}
+
+	// 子命令 flag 允许与位置参数交错，并重复接受 -config / -o，便于 "schedctl jobs list -o json"
+	fs := flag.NewFlagSet("schedctl "+cmd.group+" "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	registerGlobalFlags(fs, &configPath, &output)
+	body := cmd.setup(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "用法: schedctl %s %s %s\n  %s\n", cmd.group, cmd.name, cmd.args, cmd.help)
+		fs.PrintDefaults()
+	}
+	pos, err := parseInterspersed(fs, rest[2:])
+	if err != nil {
+		return 2
+	}
+	if len(pos) != cmd.nargs {
+		fs.Usage()
+		return 2
+	}
+
+	var cfg *CtlConfig
+	if !cmd.offline {
+		if cfg, err = LoadConfig(resolveConfigPath(configPath)); err != nil {
+			fmt.Fprintf(stderr, "schedctl: %v\n", err)
+			return 2
+		}
+		if output == "" {
+			output = cfg.Output
+		}
+	}
+	if output == "" {
+		output = outputTable
+	}
+	if output != outputTable && output != outputJSON {
+		fmt.Fprintf(stderr, "schedctl: -o 只支持 %s / %s\n", outputTable, outputJSON)
+		return 2
+	}
+
+	e := &env{out: &printer{w: stdout, json: output == outputJSON}, stderr: stderr, now: time.Now}
+	if !cmd.offline {
+		if e.admin, err = dial(ctx, cfg); err != nil {
+			fmt.Fprintf(stderr, "schedctl: %v\n", err)
+			return 1
+		}
+		defer e.admin.Close()
+	}
+	if err := body(ctx, e, pos); err != nil {
+		fmt.Fprintf(stderr, "schedctl: %v\n", err)
+		if errors.Is(err, errUsage) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+// dial 按配置连接 scheduler。
+func dial(ctx context.Context, cfg *CtlConfig) (*scheduler.AdminClient, error) {
+	schedCfg := scheduler.Config{
+		Endpoint:      cfg.Endpoint,
+		AppName:       cfg.AppName,
+		AppKey:        cfg.AppKey,
+		AppSecret:     cfg.AppSecret,
+		AdminToken:    cfg.AdminToken,
+		DialTimeout:   cfg.TimeoutDuration(),
+		SubmitTimeout: cfg.TimeoutDuration(),
+	}
+	// 逗号分隔的多个地址按多副本连接
+	if addrs := strings.Split(cfg.Endpoint, ","); len(addrs) > 1 {
+		for i := range addrs {
+			addrs[i] = strings.TrimSpace(addrs[i])
+		}
+		schedCfg.Endpoint, schedCfg.Endpoints = "", addrs
+	}
+	return scheduler.NewAdminClient(ctx, schedCfg)
+}
+
+func registerGlobalFlags(fs *flag.FlagSet, configPath, output *string) {
+	fs.StringVar(configPath, "config", *configPath, "配置文件路径（默认 $SCHEDCTL_CONFIG 或 ~/.schedctl.yaml）")
+	fs.StringVar(output, "o", *output, "输出格式 table / json（默认取配置文件 output）")
+}
+
+// parseInterspersed 解析 flag 并收集位置参数，允许二者任意交错。
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var pos []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return pos, nil
+		}
+		pos = append(pos, args[0])
+		args = args[1:]
+	}
+}
+
+func lookupCommand(group, name string) *command {
+	for i := range commands {
+		if commands[i].group == group && commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "用法: schedctl [-config schedctl.yaml] [-o table|json] <资源> <动作> [参数]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "命令:")
+	sorted := append([]command(nil), commands...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].group < sorted[j].group })
+	for _, c := range sorted {
+		fmt.Fprintf(w, "  %-36s %s\n", strings.TrimSpace(c.group+" "+c.name+" "+c.args), c.help)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "各命令的参数: schedctl <资源> <动作> -h")
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// newFake 启动 fake scheduler 并通过环境变量注入连接配置。
+func newFake(t *testing.T) *schedulertest.Server {
+	t.Helper()
+	srv := schedulertest.NewServer(schedulertest.Options{})
+	t.Cleanup(srv.Close)
+	t.Setenv(envConfig, "")
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(envEndpoint, srv.Addr())
+	t.Setenv(envAppName, srv.AppName())
+	t.Setenv(envAppKey, srv.AppKey())
+	t.Setenv(envAppSecret, srv.AppSecret())
+	t.Setenv(envAdminToken, "")
+	return srv
+}
+
+func runCtl(t *testing.T, args ...string) (code int, stdout, stderr string) {
+	t.Helper()
+	var out, errOut bytes.Buffer
+	code = run(context.Background(), args, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestRun_JobsAndRuns(t *testing.T) {
+	srv := newFake(t)
+	for _, name := range []string{"export", "cleanup"} {
+		if _, err := srv.CreateJob(context.Background(), &pb.CreateJobRequest{Job: &pb.Job{JobName: name, AppName: srv.AppName()}}); err != nil {
+			t.Fatalf("seed job: %v", err)
+		}
+	}
+
+	code, out, errOut := runCtl(t, "jobs", "list")
+	if code != 0 || !strings.HasPrefix(out, "NAME") || !strings.Contains(out, "export") || !strings.Contains(out, "cleanup") {
+		t.Fatalf("jobs list code=%d out=%q err=%q", code, out, errOut)
+	}
+
+	code, out, _ = runCtl(t, "jobs", "pause", "export", "-o", "json")
+	var job map[string]any
+	if code != 0 || json.Unmarshal([]byte(out), &[]any{&job}) != nil || job["status"] != "paused" {
+		t.Fatalf("jobs pause code=%d out=%q", code, out)
+	}
+
+	code, out, _ = runCtl(t, "-o", "json", "jobs", "trigger", "export", "--biz-key", "order-1")
+	var trig map[string]string
+	if code != 0 || json.Unmarshal([]byte(out), &trig) != nil || trig["run_id"] == "" {
+		t.Fatalf("jobs trigger code=%d out=%q", code, out)
+	}
+	runID := trig["run_id"]
+
+	code, out, _ = runCtl(t, "runs", "list", "--job", "export", "--since", "1h", "--status", "pending")
+	if code != 0 || !strings.Contains(out, runID) || !strings.Contains(out, "order-1") {
+		t.Fatalf("runs list code=%d out=%q", code, out)
+	}
+	if code, out, _ = runCtl(t, "runs", "cancel", runID, "--reason", "test"); code != 0 {
+		t.Fatalf("runs cancel code=%d out=%q", code, out)
+	}
+	code, out, _ = runCtl(t, "runs", "get", runID)
+	if code != 0 || !strings.Contains(out, "CANCELED") {
+		t.Fatalf("runs get code=%d out=%q", code, out)
+	}
+	code, out, _ = runCtl(t, "runs", "retry", runID)
+	if code != 0 || !strings.Contains(out, "new_run_id") {
+		t.Fatalf("runs retry code=%d out=%q", code, out)
+	}
+
+	// 服务端错误：退出码 1
+	if code, _, errOut = runCtl(t, "jobs", "get", "missing"); code != 1 || !strings.Contains(errOut, "not found") {
+		t.Fatalf("jobs get missing code=%d err=%q", code, errOut)
+	}
+}
+
+func TestRun_JobsSync(t *testing.T) {
+	srv := newFake(t)
+	path := filepath.Join(t.TempDir(), "jobs.yaml")
+	if err := os.WriteFile(path, []byte("jobs:\n  - name: export\n    fixedRate: 5m\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	code, out, errOut := runCtl(t, "jobs", "sync", path, "--dry-run")
+	if code != 0 || out != "+ create export\n= 0 unchanged\n" || len(srv.Jobs()) != 0 {
+		t.Fatalf("dry run code=%d out=%q err=%q", code, out, errOut)
+	}
+	code, out, _ = runCtl(t, "-o", "json", "jobs", "sync", path)
+	var changes []map[string]any
+	if code != 0 || json.Unmarshal([]byte(out), &changes) != nil || len(changes) != 1 || changes[0]["action"] != "create" {
+		t.Fatalf("sync code=%d out=%q", code, out)
+	}
+	if jobs := srv.Jobs(); len(jobs) != 1 || jobs[0].FixedRateSeconds != 300 {
+		t.Fatalf("jobs=%v", jobs)
+	}
+}
+
+func TestRun_OfflineCron(t *testing.T) {
+	// 不读取配置、不连接 scheduler
+	t.Setenv(envConfig, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	code, out, errOut := runCtl(t, "cron", "next", "0 0 8 * * mon-fri", "--tz", "Asia/Shanghai", "-n", "3", "--from", "1767225600")
+	// 1767225600 = 2026-01-01 08:00 +08:00（周四），严格晚于起点
+	want := []string{"2026-01-02 08:00:00 +08:00  Fri", "2026-01-05 08:00:00 +08:00  Mon", "2026-01-06 08:00:00 +08:00  Tue"}
+	if code != 0 || !strings.HasPrefix(out, "NEXT_RUN") || strings.Count(out, "\n") != 4 {
+		t.Fatalf("cron next code=%d out=%q err=%q", code, out, errOut)
+	}
+	for _, w := range want {
+		if !strings.Contains(out, w) {
+			t.Fatalf("cron next out=%q, missing %q", out, w)
+		}
+	}
+	code, out, _ = runCtl(t, "-o", "json", "cron", "next", "@hourly", "-n", "2")
+	var times []time.Time
+	if code != 0 || json.Unmarshal([]byte(out), &times) != nil || len(times) != 2 || times[1].Sub(times[0]) != time.Hour {
+		t.Fatalf("cron next json code=%d out=%q", code, out)
+	}
+	if code, _, errOut = runCtl(t, "cron", "next", "0 0 25 * * *"); code != 1 || !strings.Contains(errOut, "field 3") {
+		t.Fatalf("invalid cron code=%d err=%q", code, errOut)
+	}
+
+	path := filepath.Join(t.TempDir(), "jobs.yaml")
+	yaml := "jobs:\n  - name: report\n    cronExpr: '0 0 8 * * *'\n    timezone: Asia/Shanghai\n  - name: export\n    fixedRate: 5m\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	code, out, errOut = runCtl(t, "jobs", "validate", path, "-n", "2")
+	if code != 0 || strings.Count(out, "08:00:00 +08:00") != 2 || !strings.Contains(out, "every 5m") {
+		t.Fatalf("jobs validate code=%d out=%q err=%q", code, out, errOut)
+	}
+	if err := os.WriteFile(path, []byte("jobs:\n  - name: report\n    cronExpr: '0 0 8 * *'\n    timezone: Asia/Nowhere\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if code, _, errOut = runCtl(t, "jobs", "validate", path); code != 1 || !strings.Contains(errOut, "Asia/Nowhere") {
+		t.Fatalf("jobs validate invalid code=%d err=%q", code, errOut)
+	}
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	newFake(t)
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "缺少命令", args: nil},
+		{name: "未知命令", args: []string{"jobs", "explode"}},
+		{name: "缺少位置参数", args: []string{"jobs", "get"}},
+		{name: "未知 flag", args: []string{"jobs", "list", "--nope"}},
+		{name: "非法输出格式", args: []string{"-o", "yaml", "jobs", "list"}},
+		{name: "未知状态", args: []string{"runs", "list", "--status", "sleeping"}},
+		{name: "reset-secret 未确认", args: []string{"apps", "reset-secret", "iot-ops"}},
+		{name: "非法变更 id", args: []string{"changes", "approve", "abc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code, _, _ := runCtl(t, tt.args...); code != 2 {
+				t.Fatalf("exit code got=%d want=2", code)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schedctl.yaml")
+	if err := os.WriteFile(path, []byte("endpoint: a:1\nappName: app\nappKey: k\nappSecret: s\nadminToken: t\ntimeout: 3s\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{envEndpoint, envAppName, envAppKey, envAppSecret, envAdminToken} {
+		t.Setenv(k, "")
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil || cfg.Endpoint != "a:1" || cfg.TimeoutDuration() != 3*time.Second || cfg.Output != outputTable {
+		t.Fatalf("cfg=%+v err=%v", cfg, err)
+	}
+
+	t.Setenv(envEndpoint, "b:2")
+	if cfg, err = LoadConfig(path); err != nil || cfg.Endpoint != "b:2" {
+		t.Fatalf("env override cfg=%+v err=%v", cfg, err)
+	}
+
+	if _, err := LoadConfig(filepath.Join(dir, "missing.yaml")); err == nil || !strings.Contains(err.Error(), "读取配置文件失败") {
+		t.Fatalf("missing file err=%v", err)
+	}
+	t.Setenv(envAppSecret, "")
+	if err := os.WriteFile(path, []byte("endpoint: a:1\nappName: app\nappKey: k\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(path); err == nil {
+		t.Fatal("expect error for empty appSecret")
+	}
+}
+
+func TestParseTimeArg(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "", want: time.Time{}},
+		{in: "24h", want: now.Add(-24 * time.Hour)},
+		{in: "1760000000", want: time.Unix(1760000000, 0)},
+		{in: "2026-10-01", want: time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)},
+		{in: "2026-10-01 08:30:00", want: time.Date(2026, 10, 1, 8, 30, 0, 0, time.Local)},
+	}
+	for _, tt := range tests {
+		got, err := parseTimeArg(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Fatalf("parseTimeArg(%q)=%v err=%v want=%v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := parseTimeArg("yesterday", now); err == nil {
+		t.Fatal("expect error")
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// 输出格式。
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// printer 按 table / json 输出命令结果；table 面向值班人员阅读，json 便于管道给 jq。
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+// field 单条 key/value，用于详情与操作结果输出。
+type field struct {
+	Key   string
+	Value string
+}
+
+var protoJSON = protojson.MarshalOptions{UseProtoNames: true}
+
+// printMessages 输出列表：table 模式按 header + row 渲染，json 模式输出 proto 原始字段数组。
+func printMessages[T proto.Message](p *printer, items []T, header []string, row func(T) []string) error {
+	if p.json {
+		raws := make([]json.RawMessage, 0, len(items))
+		for _, it := range items {
+			b, err := protoJSON.Marshal(it)
+			if err != nil {
+				return err
+			}
+			raws = append(raws, b)
+		}
+		return p.writeJSON(raws)
+	}
+	rows := make([][]string, 0, len(items))
+	for _, it := range items {
+		rows = append(rows, row(it))
+	}
+	return writeTable(p.w, header, rows)
+}
+
+// printMessage 输出单个对象：table 模式按 fields 纵向展示，json 模式输出 proto 原始字段。
+func printMessage(p *printer, msg proto.Message, fields []field) error {
+	if p.json {
+		b, err := protoJSON.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		return p.writeJSON(json.RawMessage(b))
+	}
+	return writeFields(p.w, fields)
+}
+
+// printFields 输出操作结果（如 trigger 返回的 run_id）。
+func printFields(p *printer, fields []field) error {
+	if p.json {
+		obj := make(map[string]string, len(fields))
+		for _, f := range fields {
+			obj[f.Key] = f.Value
+		}
+		return p.writeJSON(obj)
+	}
+	return writeFields(p.w, fields)
+}
+
+func (p *printer) writeJSON(v any) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func writeTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, r := range rows {
+		fmt.Fprintln(tw, strings.Join(r, "\t"))
+	}
+	return tw.Flush()
+}
+
+func writeFields(w io.Writer, fields []field) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, f := range fields {
+		fmt.Fprintf(tw, "%s:\t%s\n", f.Key, f.Value)
+	}
+	return tw.Flush()
+}
+
+// trimEnum 去掉 proto 枚举名的公共前缀：RUN_STATUS_FAILED → FAILED。
+func trimEnum(name, prefix string) string {
+	return strings.TrimPrefix(name, prefix)
+}
+
+// fmtUnix 格式化秒级时间戳；0 显示为 "-"。
+func fmtUnix(sec int64) string {
+	if sec <= 0 {
+		return "-"
+	}
+	return time.Unix(sec, 0).Format("2006-01-02 15:04:05")
+}
+
+// fmtUnixMilli 格式化毫秒级时间戳；0 显示为 "-"。
+func fmtUnixMilli(ms int64) string {
+	if ms <= 0 {
+		return "-"
+	}
+	return time.UnixMilli(ms).Format("2006-01-02 15:04:05")
+}
+
+// cronTimeLayout cron 预览的时间格式，带时区偏移以便核对 --tz / timezone。
+const cronTimeLayout = "2006-01-02 15:04:05 Z07:00"
+
+// fmtTimes 以逗号连接 cron 预览时间。
+func fmtTimes(times []time.Time) string {
+	s := make([]string, len(times))
+	for i, t := range times {
+		s[i] = t.Format(cronTimeLayout)
+	}
+	return strings.Join(s, ", ")
+}
+
+func fmtDurationMs(ms int32) string {
+	if ms <= 0 {
+		return "-"
+	}
+	return (time.Duration(ms) * time.Millisecond).String()
+}
+
+func fmtBool(b bool) string { return strconv.FormatBool(b) }
+
+func emptyAs(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
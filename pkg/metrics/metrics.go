@@ -84,6 +84,26 @@ func Rate(name string) *RateMetric {
 	return ensureRegistry().GetOrCreateRate(name)
 }
 
+// CounterWithTags 获取/创建带 tag 的全局计数器序列（同名不同 tag 独立计数，如按 job 区分）
+func CounterWithTags(name string, tags map[string]string) *CounterMetric {
+	return ensureRegistry().GetOrCreateCounterWithTags(name, tags)
+}
+
+// GaugeWithTags 获取/创建带 tag 的全局瞬时值序列
+func GaugeWithTags(name string, tags map[string]string) *GaugeMetric {
+	return ensureRegistry().GetOrCreateGaugeWithTags(name, tags)
+}
+
+// HistogramWithTags 获取/创建带 tag 的全局直方图序列
+func HistogramWithTags(name string, tags map[string]string) *HistogramMetric {
+	return ensureRegistry().GetOrCreateHistogramWithTags(name, tags)
+}
+
+// RateWithTags 获取/创建带 tag 的全局比率序列
+func RateWithTags(name string, tags map[string]string) *RateMetric {
+	return ensureRegistry().GetOrCreateRateWithTags(name, tags)
+}
+
 // GetRegistry 返回全局 registry 实例（高级用法）
 func GetRegistry() *Registry {
 	return defaultRegistry
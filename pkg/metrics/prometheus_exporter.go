@@ -12,10 +12,11 @@ type PrometheusExporter struct {
 	registry *prometheus.Registry
 
 	// prometheus 原生指标缓存（避免重复创建）
-	promCounters   sync.Map // name -> prometheus.Counter
-	promGauges     sync.Map // name -> prometheus.Gauge
-	promHistograms sync.Map // name -> prometheus.Summary (用 Summary 表达分位数)
-	promRates      sync.Map // name -> prometheus.Gauge (失败率用 gauge 表示)
+	// key 为 SeriesKey(name, tags)：同名不同 tag 的序列各自对应一个带 ConstLabels 的 collector
+	promCounters   sync.Map // seriesKey -> prometheus.Counter
+	promGauges     sync.Map // seriesKey -> prometheus.Gauge
+	promHistograms sync.Map // seriesKey -> prometheus.Summary (用 Summary 表达分位数)
+	promRates      sync.Map // seriesKey -> prometheus.Gauge (失败率用 gauge 表示)
 }
 
 // NewPrometheusExporter 创建 exporter，传入自定义 registry 或 nil（使用默认 registry）
@@ -50,7 +51,7 @@ func (e *PrometheusExporter) exportCounter(snap *Snapshot) {
 		return
 	}
 
-	key := snap.Name
+	key := SeriesKey(snap.Name, snap.Tags)
 	var counter prometheus.Counter
 
 	if v, ok := e.promCounters.Load(key); ok {
@@ -84,7 +85,7 @@ func (e *PrometheusExporter) exportGauge(snap *Snapshot) {
 		return
 	}
 
-	key := snap.Name
+	key := SeriesKey(snap.Name, snap.Tags)
 	var gauge prometheus.Gauge
 
 	if v, ok := e.promGauges.Load(key); ok {
@@ -131,7 +132,8 @@ func (e *PrometheusExporter) exportHistogram(snap *Snapshot) {
 func (e *PrometheusExporter) exportHistogramQuantile(name string, tags map[string]string, value float64) {
 	var gauge prometheus.Gauge
 
-	if v, ok := e.promGauges.Load(name); ok {
+	key := SeriesKey(name, tags)
+	if v, ok := e.promGauges.Load(key); ok {
 		gauge = v.(prometheus.Gauge)
 	} else {
 		gauge = prometheus.NewGauge(prometheus.GaugeOpts{
@@ -146,7 +148,7 @@ func (e *PrometheusExporter) exportHistogramQuantile(name string, tags map[strin
 				return
 			}
 		}
-		e.promGauges.Store(name, gauge)
+		e.promGauges.Store(key, gauge)
 	}
 
 	gauge.Set(value)
@@ -170,7 +172,8 @@ func (e *PrometheusExporter) exportRate(snap *Snapshot) {
 func (e *PrometheusExporter) exportRateGauge(name string, tags map[string]string, value float64) {
 	var gauge prometheus.Gauge
 
-	if v, ok := e.promRates.Load(name); ok {
+	key := SeriesKey(name, tags)
+	if v, ok := e.promRates.Load(key); ok {
 		gauge = v.(prometheus.Gauge)
 	} else {
 		gauge = prometheus.NewGauge(prometheus.GaugeOpts{
@@ -185,7 +188,7 @@ func (e *PrometheusExporter) exportRateGauge(name string, tags map[string]string
 				return
 			}
 		}
-		e.promRates.Store(name, gauge)
+		e.promRates.Store(key, gauge)
 	}
 
 	gauge.Set(value)
@@ -1,6 +1,8 @@
 package metrics
 
 import (
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
@@ -10,10 +12,10 @@ type SnapshotCallback func(snapshots map[string]*Snapshot)
 
 // Registry 全局指标注册表
 type Registry struct {
-	counters   sync.Map // name -> *CounterMetric
-	gauges     sync.Map // name -> *GaugeMetric
-	histograms sync.Map // name -> *HistogramMetric
-	rates      sync.Map // name -> *RateMetric
+	counters   sync.Map // seriesKey -> *CounterMetric
+	gauges     sync.Map // seriesKey -> *GaugeMetric
+	histograms sync.Map // seriesKey -> *HistogramMetric
+	rates      sync.Map // seriesKey -> *RateMetric
 
 	serviceName string
 	interval    time.Duration
@@ -40,6 +42,72 @@ func (r *Registry) Stop() {
 	<-r.stopped
 }
 
+// SeriesKey 同名不同 tag 的序列标识：name{k1=v1,k2=v2}（tag 按 key 排序）；无 tag 时即 name。
+// 也是快照回调 map 的 key，告警规则按带 tag 的序列配置时 MetricName 填此值。
+func SeriesKey(name string, tags map[string]string) string {
+	if len(tags) == 0 {
+		return name
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(tags[k])
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// GetOrCreateCounterWithTags 获取或创建带 tag 的计数器序列；同名不同 tag 各自独立计数
+func (r *Registry) GetOrCreateCounterWithTags(name string, tags map[string]string) *CounterMetric {
+	key := SeriesKey(name, tags)
+	if v, ok := r.counters.Load(key); ok {
+		return v.(*CounterMetric)
+	}
+	actual, _ := r.counters.LoadOrStore(key, newCounter(name).WithTags(tags))
+	return actual.(*CounterMetric)
+}
+
+// GetOrCreateGaugeWithTags 获取或创建带 tag 的瞬时值序列
+func (r *Registry) GetOrCreateGaugeWithTags(name string, tags map[string]string) *GaugeMetric {
+	key := SeriesKey(name, tags)
+	if v, ok := r.gauges.Load(key); ok {
+		return v.(*GaugeMetric)
+	}
+	actual, _ := r.gauges.LoadOrStore(key, newGauge(name).WithTags(tags))
+	return actual.(*GaugeMetric)
+}
+
+// GetOrCreateHistogramWithTags 获取或创建带 tag 的直方图序列
+func (r *Registry) GetOrCreateHistogramWithTags(name string, tags map[string]string) *HistogramMetric {
+	key := SeriesKey(name, tags)
+	if v, ok := r.histograms.Load(key); ok {
+		return v.(*HistogramMetric)
+	}
+	actual, _ := r.histograms.LoadOrStore(key, newHistogram(name).WithTags(tags))
+	return actual.(*HistogramMetric)
+}
+
+// GetOrCreateRateWithTags 获取或创建带 tag 的比率序列
+func (r *Registry) GetOrCreateRateWithTags(name string, tags map[string]string) *RateMetric {
+	key := SeriesKey(name, tags)
+	if v, ok := r.rates.Load(key); ok {
+		return v.(*RateMetric)
+	}
+	actual, _ := r.rates.LoadOrStore(key, newRate(name).WithTags(tags))
+	return actual.(*RateMetric)
+}
+
 // GetOrCreateCounter 获取或创建计数器
 func (r *Registry) GetOrCreateCounter(name string) *CounterMetric {
 	if v, ok := r.counters.Load(name); ok {
@@ -104,12 +172,12 @@ func (r *Registry) collectAndNotify() {
 	now := time.Now()
 	snapshots := make(map[string]*Snapshot)
 
-	r.counters.Range(func(key, value interface{}) bool {
-		name := key.(string)
+	r.counters.Range(func(k, value interface{}) bool {
+		key := k.(string)
 		c := value.(*CounterMetric)
 		v := c.Reset()
-		snapshots[name] = &Snapshot{
-			Name:        name,
+		snapshots[key] = &Snapshot{
+			Name:        c.Name(),
 			Type:        MetricTypeCounter,
 			Counter:     &v,
 			Tags:        c.Tags(),
@@ -119,12 +187,12 @@ func (r *Registry) collectAndNotify() {
 		return true
 	})
 
-	r.gauges.Range(func(key, value interface{}) bool {
-		name := key.(string)
+	r.gauges.Range(func(k, value interface{}) bool {
+		key := k.(string)
 		g := value.(*GaugeMetric)
 		v := g.Value()
-		snapshots[name] = &Snapshot{
-			Name:        name,
+		snapshots[key] = &Snapshot{
+			Name:        g.Name(),
 			Type:        MetricTypeGauge,
 			Gauge:       &v,
 			Tags:        g.Tags(),
@@ -134,12 +202,12 @@ func (r *Registry) collectAndNotify() {
 		return true
 	})
 
-	r.histograms.Range(func(key, value interface{}) bool {
-		name := key.(string)
+	r.histograms.Range(func(k, value interface{}) bool {
+		key := k.(string)
 		h := value.(*HistogramMetric)
 		snap := h.Reset()
-		snapshots[name] = &Snapshot{
-			Name:        name,
+		snapshots[key] = &Snapshot{
+			Name:        h.Name(),
 			Type:        MetricTypeHistogram,
 			Histogram:   &snap,
 			Tags:        h.Tags(),
@@ -149,12 +217,12 @@ func (r *Registry) collectAndNotify() {
 		return true
 	})
 
-	r.rates.Range(func(key, value interface{}) bool {
-		name := key.(string)
+	r.rates.Range(func(k, value interface{}) bool {
+		key := k.(string)
 		rt := value.(*RateMetric)
 		snap := rt.Reset()
-		snapshots[name] = &Snapshot{
-			Name:        name,
+		snapshots[key] = &Snapshot{
+			Name:        rt.Name(),
 			Type:        MetricTypeRate,
 			Rate:        &snap,
 			Tags:        rt.Tags(),
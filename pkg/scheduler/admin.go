@@ -0,0 +1,176 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 管理类 RPC 的类型化错误；AdminClient 返回的 error 可用 errors.Is 判断，
+// 同时保留原始 gRPC status（status.FromError / status.Code 仍可用）。
+var (
+	// ErrNotFound 目标 job / run / app / 告警对象不存在（codes.NotFound）。
+	ErrNotFound = errors.New("scheduler: not found")
+	// ErrAlreadyExists 创建的对象已存在（codes.AlreadyExists）。
+	ErrAlreadyExists = errors.New("scheduler: already exists")
+	// ErrPermissionDenied 调用方未通过鉴权或无权操作目标对象（codes.PermissionDenied / codes.Unauthenticated）。
+	ErrPermissionDenied = errors.New("scheduler: permission denied")
+	// ErrRejected 服务端受理了请求但返回 ok=false（如删除确认不匹配、渠道测试失败）。
+	ErrRejected = errors.New("scheduler: rejected by server")
+)
+
+// defaultAdminPageSize List 迭代器的默认分页大小。
+const defaultAdminPageSize = 100
+
+// AdminClient 是 SchedulerService / AppService / AlertService 管理面的类型化封装。
+//
+// 与 Client 共用同一组签名凭据（每次调用经 hmacCredentials 在 metadata 中附带新签名），配置了 Config.AdminToken /
+// AdminTokenSource 时另附带 JWT。供运维脚本、内部工具替代手写 gRPC 调用：
+//
+//	admin, err := scheduler.NewAdminClient(ctx, cfg)
+//	if err != nil { return err }
+//	defer admin.Close()
+//	for job, err := range admin.Jobs(ctx, scheduler.JobFilter{Status: "paused"}) {
+//	    if err != nil { return err }
+//	    fmt.Println(job.JobName)
+//	}
+//
+// 所有方法并发安全；未设置 deadline 的 ctx 统一套用 Config.SubmitTimeout。
+type AdminClient struct {
+	cfg   Config
+	conn  *grpc.ClientConn
+	owned bool // NewAdminClient 自行拨号的连接由 Close 关闭；Client.Admin 共享的连接由 Client.Stop 关闭
+	// routing NewAdminClient 多副本模式的路由状态（不注册 worker，从响应头 x-scheduler-leader 发现 leader）
+	routing *endpointRouting
+
+	sched  pb.SchedulerServiceClient
+	apps   pb.AppServiceClient
+	alerts pb.AlertServiceClient
+}
+
+// NewAdminClient 校验 cfg 并拨号，返回独立持有连接的 AdminClient；用完调用 Close。
+//
+// 只用到 Config 的连接与凭据字段（Endpoint / Endpoints / EndpointResolver / AppName / AppKey / AppSecret / AdminToken /
+// AdminTokenSource / DialTimeout / SubmitTimeout / 消息大小），
+// 不注册 worker、不启动 stream。本地模式（Config.LocalMode）的引擎随 Client 存在，请改用 Client.Admin。
+func NewAdminClient(ctx context.Context, cfg Config) (*AdminClient, error) {
+	if cfg.LocalMode {
+		return nil, errors.New("scheduler: NewAdminClient does not support LocalMode, use Client.Admin")
+	}
+	cfg.applyDefaults()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	clock := newClockSkew(&cfg)
+	routing := newEndpointRouting(&cfg, clock)
+	conn, err := dial(ctx, &cfg, clock, routing)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler: dial %s: %w", cfg.endpointDesc(), err)
+	}
+	a := newAdminClient(cfg, conn)
+	a.owned, a.routing = true, routing
+	return a, nil
+}
+
+// Admin 返回复用本 Client 连接的 AdminClient；必须在 Start 之后调用，连接随 Stop 关闭。
+func (c *Client) Admin() (*AdminClient, error) {
+	if c.conn == nil {
+		return nil, ErrNotStarted
+	}
+	return newAdminClient(c.cfg, c.conn), nil
+}
+
+func newAdminClient(cfg Config, conn *grpc.ClientConn) *AdminClient {
+	return &AdminClient{
+		cfg:    cfg,
+		conn:   conn,
+		sched:  pb.NewSchedulerServiceClient(conn),
+		apps:   pb.NewAppServiceClient(conn),
+		alerts: pb.NewAlertServiceClient(conn),
+	}
+}
+
+// Close 关闭 NewAdminClient 拨号的连接；Client.Admin 返回的实例调用 Close 无副作用。
+func (a *AdminClient) Close() error {
+	if !a.owned {
+		return nil
+	}
+	a.routing.close()
+	return a.conn.Close()
+}
+
+// withTimeout 未设置 deadline 时套用 Config.SubmitTimeout。
+func (a *AdminClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, a.cfg.SubmitTimeout)
+}
+
+// adminErr 把 gRPC 错误包装为 "op: 类型化错误: 原始错误"；非以上三类 code 仅加 op 前缀。
+func adminErr(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return fmt.Errorf("%s: %w: %w", op, ErrNotFound, err)
+	case codes.AlreadyExists:
+		return fmt.Errorf("%s: %w: %w", op, ErrAlreadyExists, err)
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return fmt.Errorf("%s: %w: %w", op, ErrPermissionDenied, err)
+	default:
+		return fmt.Errorf("%s: %w", op, err)
+	}
+}
+
+// rejectedErr 把 {ok=false, error=msg} 形式的响应转为 ErrRejected。
+func rejectedErr(op, msg string) error {
+	if msg == "" {
+		return fmt.Errorf("%s: %w", op, ErrRejected)
+	}
+	return fmt.Errorf("%s: %w: %s", op, ErrRejected, msg)
+}
+
+// pageFetcher 拉取第 page 页（从 1 开始），返回本页数据与服务端报告的总数。
+type pageFetcher[T any] func(ctx context.Context, page, pageSize int32) (items []T, total int64, err error)
+
+// paginate 把分页 List 接口转为 iter.Seq2：按页拉取直到取满 total 或遇到空页 / 不满页。
+//
+// 出错时 yield (零值, err) 后结束；调用方 break 时不再发起后续请求。
+func paginate[T any](ctx context.Context, pageSize int32, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = defaultAdminPageSize
+	}
+	return func(yield func(T, error) bool) {
+		var seen int64
+		for page := int32(1); ; page++ {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			items, total, err := fetch(ctx, page, pageSize)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, it := range items {
+				if !yield(it, nil) {
+					return
+				}
+			}
+			seen += int64(len(items))
+			if len(items) < int(pageSize) || (total > 0 && seen >= total) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package scheduler
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// AlertEventFilter ListAlertEvents / AlertEvents 的过滤条件；零值字段不参与过滤。
+type AlertEventFilter struct {
+	JobName string
+	Status  string // firing / resolved / escalated / silenced
+	Since   time.Time
+	Until   time.Time
+
+	// PageSize 每页条数；AlertEvents 迭代器默认 100
+	PageSize int32
+}
+
+func (f AlertEventFilter) request(page, pageSize int32) *pb.ListAlertEventsRequest {
+	req := &pb.ListAlertEventsRequest{
+		JobName:  f.JobName,
+		Status:   f.Status,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if !f.Since.IsZero() {
+		req.Since = f.Since.Unix()
+	}
+	if !f.Until.IsZero() {
+		req.Until = f.Until.Unix()
+	}
+	return req
+}
+
+// ===== 告警规则 =====
+
+// ListAlertRules 列出告警规则；enabledOnly=true 时只返回启用的规则。
+func (a *AdminClient) ListAlertRules(ctx context.Context, keyword string, enabledOnly bool) ([]*pb.AlertRule, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.ListAlertRules(ctx, &pb.ListAlertRulesRequest{Keyword: keyword, EnabledOnly: enabledOnly})
+	if err != nil {
+		return nil, adminErr("list alert rules", err)
+	}
+	return resp.Rules, nil
+}
+
+// GetAlertRule 查询告警规则；不存在返回 ErrNotFound。
+func (a *AdminClient) GetAlertRule(ctx context.Context, id int64) (*pb.AlertRule, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	rule, err := a.alerts.GetAlertRule(ctx, &pb.GetAlertRuleRequest{Id: id})
+	return rule, adminErr("get alert rule", err)
+}
+
+// CreateAlertRule 创建告警规则。
+func (a *AdminClient) CreateAlertRule(ctx context.Context, rule *pb.AlertRule) (*pb.AlertRule, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	created, err := a.alerts.CreateAlertRule(ctx, &pb.CreateAlertRuleRequest{Rule: rule})
+	return created, adminErr("create alert rule "+rule.RuleName, err)
+}
+
+// UpdateAlertRule 按 rule.Id 更新告警规则。
+func (a *AdminClient) UpdateAlertRule(ctx context.Context, rule *pb.AlertRule) (*pb.AlertRule, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	updated, err := a.alerts.UpdateAlertRule(ctx, &pb.UpdateAlertRuleRequest{Rule: rule})
+	return updated, adminErr("update alert rule "+rule.RuleName, err)
+}
+
+// DeleteAlertRule 删除告警规则；仍有绑定时服务端拒绝（ErrRejected）。
+func (a *AdminClient) DeleteAlertRule(ctx context.Context, id int64) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.DeleteAlertRule(ctx, &pb.DeleteAlertRuleRequest{Id: id})
+	if err != nil {
+		return adminErr("delete alert rule", err)
+	}
+	if !resp.Ok {
+		return rejectedErr("delete alert rule", resp.Error)
+	}
+	return nil
+}
+
+// ===== 通知渠道 =====
+
+// ListAlertChannels 列出通知渠道；channelType 为 UNSPECIFIED 表示全部。
+func (a *AdminClient) ListAlertChannels(ctx context.Context, channelType pb.AlertChannelType) ([]*pb.AlertChannel, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.ListAlertChannels(ctx, &pb.ListAlertChannelsRequest{ChannelType: channelType})
+	if err != nil {
+		return nil, adminErr("list alert channels", err)
+	}
+	return resp.Channels, nil
+}
+
+// GetAlertChannel 查询通知渠道；webhook_url 尾部脱敏。
+func (a *AdminClient) GetAlertChannel(ctx context.Context, id int64) (*pb.AlertChannel, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	ch, err := a.alerts.GetAlertChannel(ctx, &pb.GetAlertChannelRequest{Id: id})
+	return ch, adminErr("get alert channel", err)
+}
+
+// CreateAlertChannel 创建通知渠道；req.Secret 明文传输，由服务端加密存储。
+func (a *AdminClient) CreateAlertChannel(ctx context.Context, req *pb.CreateAlertChannelRequest) (*pb.AlertChannel, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	ch, err := a.alerts.CreateAlertChannel(ctx, req)
+	return ch, adminErr("create alert channel "+req.ChannelName, err)
+}
+
+// UpdateAlertChannel 更新通知渠道；req.Secret 为空表示不修改。
+func (a *AdminClient) UpdateAlertChannel(ctx context.Context, req *pb.UpdateAlertChannelRequest) (*pb.AlertChannel, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	ch, err := a.alerts.UpdateAlertChannel(ctx, req)
+	return ch, adminErr("update alert channel "+req.ChannelName, err)
+}
+
+// DeleteAlertChannel 删除通知渠道。
+func (a *AdminClient) DeleteAlertChannel(ctx context.Context, id int64) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.DeleteAlertChannel(ctx, &pb.DeleteAlertChannelRequest{Id: id})
+	if err != nil {
+		return adminErr("delete alert channel", err)
+	}
+	if !resp.Ok {
+		return rejectedErr("delete alert channel", resp.Error)
+	}
+	return nil
+}
+
+// TestAlertChannel 发送一条测试消息，返回推送耗时；推送失败返回 ErrRejected。
+func (a *AdminClient) TestAlertChannel(ctx context.Context, id int64, message string) (latency time.Duration, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.TestAlertChannel(ctx, &pb.TestAlertChannelRequest{Id: id, CustomMessage: message})
+	if err != nil {
+		return 0, adminErr("test alert channel", err)
+	}
+	latency = time.Duration(resp.LatencyMs) * time.Millisecond
+	if !resp.Ok {
+		return latency, rejectedErr("test alert channel", resp.Error)
+	}
+	return latency, nil
+}
+
+// ===== 绑定 =====
+
+// ListAlertBindings 列出 job ↔ 规则 ↔ 渠道绑定；零值参数不参与过滤。
+func (a *AdminClient) ListAlertBindings(ctx context.Context, jobName string, ruleID, channelID int64) ([]*pb.AlertBinding, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.ListAlertBindings(ctx, &pb.ListAlertBindingsRequest{JobName: jobName, RuleId: ruleID, ChannelId: channelID})
+	if err != nil {
+		return nil, adminErr("list alert bindings", err)
+	}
+	return resp.Bindings, nil
+}
+
+// BindJobAlert 为 job 绑定一条规则与渠道；重复绑定返回 ErrAlreadyExists。
+func (a *AdminClient) BindJobAlert(ctx context.Context, jobName string, ruleID, channelID int64) (*pb.AlertBinding, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	b, err := a.alerts.BindJobAlert(ctx, &pb.BindJobAlertRequest{JobName: jobName, RuleId: ruleID, ChannelId: channelID})
+	return b, adminErr("bind job alert "+jobName, err)
+}
+
+// UnbindJobAlert 解除绑定。
+func (a *AdminClient) UnbindJobAlert(ctx context.Context, bindingID int64) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.UnbindJobAlert(ctx, &pb.UnbindJobAlertRequest{Id: bindingID})
+	if err != nil {
+		return adminErr("unbind job alert", err)
+	}
+	if !resp.Ok {
+		return rejectedErr("unbind job alert", resp.Error)
+	}
+	return nil
+}
+
+// ===== 告警事件 =====
+
+// ListAlertEvents 拉取一页告警事件；page 从 1 开始。需要遍历全部结果时用 AlertEvents。
+func (a *AdminClient) ListAlertEvents(ctx context.Context, f AlertEventFilter, page int32) (events []*pb.AlertEvent, total int64, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.ListAlertEvents(ctx, f.request(page, f.PageSize))
+	if err != nil {
+		return nil, 0, adminErr("list alert events", err)
+	}
+	return resp.Events, resp.Total, nil
+}
+
+// AlertEvents 按页遍历满足 f 的全部告警事件。
+func (a *AdminClient) AlertEvents(ctx context.Context, f AlertEventFilter) iter.Seq2[*pb.AlertEvent, error] {
+	return paginate(ctx, f.PageSize, func(ctx context.Context, page, pageSize int32) ([]*pb.AlertEvent, int64, error) {
+		f.PageSize = pageSize
+		return a.ListAlertEvents(ctx, f, page)
+	})
+}
+
+// ResolveAlert 手动把告警事件标记为已恢复。
+func (a *AdminClient) ResolveAlert(ctx context.Context, id int64, operator string) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.ResolveAlert(ctx, &pb.ResolveAlertRequest{Id: id, Operator: operator})
+	if err != nil {
+		return adminErr("resolve alert", err)
+	}
+	if !resp.Ok {
+		return rejectedErr("resolve alert", resp.Error)
+	}
+	return nil
+}
+
+// SilenceAlert 静默告警事件 d（按秒取整），返回静默截止时间。
+func (a *AdminClient) SilenceAlert(ctx context.Context, id int64, d time.Duration, operator string) (until time.Time, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.alerts.SilenceAlert(ctx, &pb.SilenceAlertRequest{Id: id, DurationSec: int32(d / time.Second), Operator: operator})
+	if err != nil {
+		return time.Time{}, adminErr("silence alert", err)
+	}
+	if !resp.Ok {
+		return time.Time{}, rejectedErr("silence alert", "")
+	}
+	return time.Unix(resp.SilenceUntil, 0), nil
+}
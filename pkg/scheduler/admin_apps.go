@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"context"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// ListApps 列出应用；keyword 为空表示全部。
+func (a *AdminClient) ListApps(ctx context.Context, keyword string) ([]*pb.App, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.apps.ListApps(ctx, &pb.ListAppsRequest{Keyword: keyword})
+	if err != nil {
+		return nil, adminErr("list apps", err)
+	}
+	return resp.Apps, nil
+}
+
+// GetApp 查询应用；不存在返回 ErrNotFound。
+func (a *AdminClient) GetApp(ctx context.Context, appName string) (*pb.App, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	app, err := a.apps.GetApp(ctx, &pb.GetAppRequest{AppName: appName})
+	return app, adminErr("get app "+appName, err)
+}
+
+// CreateApp 注册应用，返回应用信息与明文 app_secret（仅此一次可见，调用方须妥善保存）。
+func (a *AdminClient) CreateApp(ctx context.Context, req *pb.CreateAppRequest) (app *pb.App, appSecret string, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.apps.CreateApp(ctx, req)
+	if err != nil {
+		return nil, "", adminErr("create app "+req.AppName, err)
+	}
+	return resp.App, resp.AppSecret, nil
+}
+
+// UpdateApp 更新应用属性；app_name 作为 key 不可修改。
+func (a *AdminClient) UpdateApp(ctx context.Context, req *pb.UpdateAppRequest) (*pb.App, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	app, err := a.apps.UpdateApp(ctx, req)
+	return app, adminErr("update app "+req.AppName, err)
+}
+
+// ResetAppSecret 重置 app_secret 并返回新的明文；旧 secret 立即失效，在线 worker 需用新 secret 重连。
+func (a *AdminClient) ResetAppSecret(ctx context.Context, appName string) (appSecret string, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.apps.ResetAppSecret(ctx, &pb.ResetAppSecretRequest{AppName: appName, Confirm: appName})
+	if err != nil {
+		return "", adminErr("reset app secret "+appName, err)
+	}
+	return resp.AppSecret, nil
+}
+
+// DisableApp 停用应用：拒绝其 Connect / SubmitTask。
+func (a *AdminClient) DisableApp(ctx context.Context, appName string) (*pb.App, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	app, err := a.apps.DisableApp(ctx, &pb.DisableAppRequest{AppName: appName})
+	return app, adminErr("disable app "+appName, err)
+}
+
+// EnableApp 重新启用应用。
+func (a *AdminClient) EnableApp(ctx context.Context, appName string) (*pb.App, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	app, err := a.apps.EnableApp(ctx, &pb.EnableAppRequest{AppName: appName})
+	return app, adminErr("enable app "+appName, err)
+}
+
+// DeleteApp 删除应用；确认字段按服务端约定填应用名。
+func (a *AdminClient) DeleteApp(ctx context.Context, appName string) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.apps.DeleteApp(ctx, &pb.DeleteAppRequest{AppName: appName, Confirm: appName})
+	if err != nil {
+		return adminErr("delete app "+appName, err)
+	}
+	if !resp.Ok {
+		return rejectedErr("delete app "+appName, resp.Error)
+	}
+	return nil
+}
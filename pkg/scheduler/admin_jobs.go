@@ -0,0 +1,301 @@
+package scheduler
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// JobFilter ListJobs / Jobs 的过滤条件；零值字段不参与过滤。
+type JobFilter struct {
+	AppName     string
+	Keyword     string
+	TriggerType pb.TriggerType
+	Status      string // waiting_worker / ready / paused / disabled
+	Priority    pb.Priority
+
+	// PageSize 每页条数；Jobs 迭代器默认 100
+	PageSize int32
+}
+
+func (f JobFilter) request(page, pageSize int32) *pb.ListJobsRequest {
+	return &pb.ListJobsRequest{
+		AppName:     f.AppName,
+		Keyword:     f.Keyword,
+		TriggerType: f.TriggerType,
+		Status:      f.Status,
+		Priority:    f.Priority,
+		Page:        page,
+		PageSize:    pageSize,
+	}
+}
+
+// RunFilter ListRuns / Runs 的过滤条件；零值字段不参与过滤。
+type RunFilter struct {
+	JobName    string
+	AppName    string
+	Status     pb.RunStatus
+	BizKeyLike string
+	WorkerID   string
+	Since      time.Time
+	Until      time.Time
+	// ParentRunID 只列出该父 run 下的分片 run
+	ParentRunID string
+
+	// PageSize 每页条数；Runs 迭代器默认 100
+	PageSize int32
+}
+
+func (f RunFilter) request(page, pageSize int32) *pb.ListRunsRequest {
+	req := &pb.ListRunsRequest{
+		JobName:     f.JobName,
+		AppName:     f.AppName,
+		Status:      f.Status,
+		BizKeyLike:  f.BizKeyLike,
+		WorkerId:    f.WorkerID,
+		ParentRunId: f.ParentRunID,
+		Page:        page,
+		PageSize:    pageSize,
+	}
+	if !f.Since.IsZero() {
+		req.Since = f.Since.Unix()
+	}
+	if !f.Until.IsZero() {
+		req.Until = f.Until.Unix()
+	}
+	return req
+}
+
+// TriggerOptions TriggerJob 的可选参数。
+type TriggerOptions struct {
+	BizKey   string
+	Payload  []byte
+	Operator string // 审计用操作人；为空时服务端记为调用方应用
+}
+
+// ===== Job =====
+
+// ListJobs 拉取一页 job；page 从 1 开始。需要遍历全部结果时用 Jobs。
+func (a *AdminClient) ListJobs(ctx context.Context, f JobFilter, page int32) (jobs []*pb.Job, total int64, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.ListJobs(ctx, f.request(page, f.PageSize))
+	if err != nil {
+		return nil, 0, adminErr("list jobs", err)
+	}
+	return resp.Jobs, resp.Total, nil
+}
+
+// Jobs 按页遍历满足 f 的全部 job。
+func (a *AdminClient) Jobs(ctx context.Context, f JobFilter) iter.Seq2[*pb.Job, error] {
+	return paginate(ctx, f.PageSize, func(ctx context.Context, page, pageSize int32) ([]*pb.Job, int64, error) {
+		f.PageSize = pageSize
+		return a.ListJobs(ctx, f, page)
+	})
+}
+
+// GetJob 查询 job；不存在返回 ErrNotFound。
+func (a *AdminClient) GetJob(ctx context.Context, jobName string) (*pb.Job, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	job, err := a.sched.GetJob(ctx, &pb.GetJobRequest{JobName: jobName})
+	return job, adminErr("get job "+jobName, err)
+}
+
+// CreateJob 创建 job；job.AppName 为空时补为 Config.AppName，同名已存在返回 ErrAlreadyExists。
+func (a *AdminClient) CreateJob(ctx context.Context, job *pb.Job) (*pb.Job, error) {
+	if job.AppName == "" {
+		job.AppName = a.cfg.AppName
+	}
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	created, err := a.sched.CreateJob(ctx, &pb.CreateJobRequest{Job: job})
+	return created, adminErr("create job "+job.JobName, err)
+}
+
+// UpdateJob 更新 job 配置。requireApproval=true 时进入双人复核；critical job 由服务端强制复核，
+// 此时返回的 job 仍是旧配置，变更在 ListPendingChanges 中可见。
+func (a *AdminClient) UpdateJob(ctx context.Context, job *pb.Job, requireApproval bool) (*pb.Job, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	updated, err := a.sched.UpdateJob(ctx, &pb.UpdateJobRequest{Job: job, RequireApproval: requireApproval})
+	return updated, adminErr("update job "+job.JobName, err)
+}
+
+// DeleteJob 删除 job；确认字段按服务端约定填 job 名。
+func (a *AdminClient) DeleteJob(ctx context.Context, jobName string) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.DeleteJob(ctx, &pb.DeleteJobRequest{JobName: jobName, Confirm: jobName})
+	if err != nil {
+		return adminErr("delete job "+jobName, err)
+	}
+	if !resp.Ok {
+		return rejectedErr("delete job "+jobName, resp.Error)
+	}
+	return nil
+}
+
+// PauseJob 暂停 job 的定时触发。
+func (a *AdminClient) PauseJob(ctx context.Context, jobName string) (*pb.Job, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	job, err := a.sched.PauseJob(ctx, &pb.PauseJobRequest{JobName: jobName})
+	return job, adminErr("pause job "+jobName, err)
+}
+
+// ResumeJob 恢复已暂停的 job。
+func (a *AdminClient) ResumeJob(ctx context.Context, jobName string) (*pb.Job, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	job, err := a.sched.ResumeJob(ctx, &pb.ResumeJobRequest{JobName: jobName})
+	return job, adminErr("resume job "+jobName, err)
+}
+
+// TriggerJob 手动触发一次（trigger_type=manual），返回新 run_id。
+func (a *AdminClient) TriggerJob(ctx context.Context, jobName string, opts TriggerOptions) (runID string, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.TriggerJob(ctx, &pb.TriggerJobRequest{
+		JobName:  jobName,
+		BizKey:   opts.BizKey,
+		Payload:  opts.Payload,
+		Operator: opts.Operator,
+	})
+	if err != nil {
+		return "", adminErr("trigger job "+jobName, err)
+	}
+	return resp.RunId, nil
+}
+
+// ===== Run =====
+
+// ListRuns 拉取一页 run；page 从 1 开始。需要遍历全部结果时用 Runs。
+func (a *AdminClient) ListRuns(ctx context.Context, f RunFilter, page int32) (runs []*pb.Run, total int64, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.ListRuns(ctx, f.request(page, f.PageSize))
+	if err != nil {
+		return nil, 0, adminErr("list runs", err)
+	}
+	return resp.Runs, resp.Total, nil
+}
+
+// Runs 按页遍历满足 f 的全部 run。
+func (a *AdminClient) Runs(ctx context.Context, f RunFilter) iter.Seq2[*pb.Run, error] {
+	return paginate(ctx, f.PageSize, func(ctx context.Context, page, pageSize int32) ([]*pb.Run, int64, error) {
+		f.PageSize = pageSize
+		return a.ListRuns(ctx, f, page)
+	})
+}
+
+// GetRun 查询 run；不存在返回 ErrNotFound。
+func (a *AdminClient) GetRun(ctx context.Context, runID string) (*pb.Run, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	run, err := a.sched.GetRun(ctx, &pb.GetRunRequest{RunId: runID})
+	return run, adminErr("get run "+runID, err)
+}
+
+// CancelRun 取消未结束的 run；已结束返回 ErrRejected。
+func (a *AdminClient) CancelRun(ctx context.Context, runID, reason string) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.CancelRun(ctx, &pb.CancelRunRequest{RunId: runID, Reason: reason})
+	if err != nil {
+		return adminErr("cancel run "+runID, err)
+	}
+	if !resp.Ok {
+		return rejectedErr("cancel run "+runID, resp.Error)
+	}
+	return nil
+}
+
+// RetryRun 以原参数重跑一次，返回新 run_id。
+func (a *AdminClient) RetryRun(ctx context.Context, runID string) (newRunID string, err error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.RetryRun(ctx, &pb.RetryRunRequest{RunId: runID})
+	if err != nil {
+		return "", adminErr("retry run "+runID, err)
+	}
+	return resp.NewRunId, nil
+}
+
+// ===== Worker / Dashboard =====
+
+// ListWorkers 列出 worker；appName / status（online / draining / offline）为空表示不过滤。
+func (a *AdminClient) ListWorkers(ctx context.Context, appName, status string) ([]*pb.Worker, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.ListWorkers(ctx, &pb.ListWorkersRequest{AppName: appName, Status: status})
+	if err != nil {
+		return nil, adminErr("list workers", err)
+	}
+	return resp.Workers, nil
+}
+
+// KickWorker 强制断开一个 worker 连接，其 inflight run 由服务端按超时重派。
+func (a *AdminClient) KickWorker(ctx context.Context, workerID, reason string) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.KickWorker(ctx, &pb.KickWorkerRequest{WorkerId: workerID, Reason: reason})
+	if err != nil {
+		return adminErr("kick worker "+workerID, err)
+	}
+	if !resp.Ok {
+		return rejectedErr("kick worker "+workerID, resp.Error)
+	}
+	return nil
+}
+
+// GetDashboard 拉取仪表盘统计；window 按小时取整，0 表示服务端默认窗口。
+func (a *AdminClient) GetDashboard(ctx context.Context, window time.Duration) (*pb.GetDashboardResponse, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.GetDashboard(ctx, &pb.GetDashboardRequest{WindowHours: int32(window / time.Hour)})
+	return resp, adminErr("get dashboard", err)
+}
+
+// ===== 双人复核 =====
+
+// ListPendingChanges 列出待复核变更；status 为空表示全部。
+func (a *AdminClient) ListPendingChanges(ctx context.Context, status string) ([]*pb.PendingChange, error) {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.ListPendingChanges(ctx, &pb.ListPendingChangesRequest{Status: status})
+	if err != nil {
+		return nil, adminErr("list pending changes", err)
+	}
+	return resp.Items, nil
+}
+
+// ApprovePendingChange 通过一条待复核变更；approver 不能与提交人相同（服务端校验）。
+func (a *AdminClient) ApprovePendingChange(ctx context.Context, id int64, approver string) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.ApprovePendingChange(ctx, &pb.ApprovePendingChangeRequest{Id: id, Approver: approver})
+	if err != nil {
+		return adminErr("approve pending change", err)
+	}
+	if !resp.Ok {
+		return rejectedErr("approve pending change", resp.Error)
+	}
+	return nil
+}
+
+// RejectPendingChange 驳回一条待复核变更。
+func (a *AdminClient) RejectPendingChange(ctx context.Context, id int64, approver, reason string) error {
+	ctx, cancel := a.withTimeout(ctx)
+	defer cancel()
+	resp, err := a.sched.RejectPendingChange(ctx, &pb.RejectPendingChangeRequest{Id: id, Approver: approver, Reason: reason})
+	if err != nil {
+		return adminErr("reject pending change", err)
+	}
+	if !resp.Ok {
+		return rejectedErr("reject pending change", resp.Error)
+	}
+	return nil
+}
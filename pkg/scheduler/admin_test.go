@@ -0,0 +1,231 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+func newFakeAdmin(t *testing.T) (*schedulertest.Server, *AdminClient) {
+	t.Helper()
+	srv := schedulertest.NewServer(schedulertest.Options{})
+	t.Cleanup(srv.Close)
+	admin, err := NewAdminClient(context.Background(), Config{
+		Endpoint:  srv.Addr(),
+		AppName:   srv.AppName(),
+		AppKey:    srv.AppKey(),
+		AppSecret: srv.AppSecret(),
+	})
+	if err != nil {
+		t.Fatalf("NewAdminClient: %v", err)
+	}
+	t.Cleanup(func() { _ = admin.Close() })
+	return srv, admin
+}
+
+func TestAdminClient_JobsIteratesAllPages(t *testing.T) {
+	_, admin := newFakeAdmin(t)
+	ctx := waitCtx(t)
+	for i := 0; i < 7; i++ {
+		if _, err := admin.CreateJob(ctx, &pb.Job{JobName: fmt.Sprintf("job-%d", i)}); err != nil {
+			t.Fatalf("CreateJob: %v", err)
+		}
+	}
+
+	var names []string
+	for job, err := range admin.Jobs(ctx, JobFilter{PageSize: 3}) {
+		if err != nil {
+			t.Fatalf("Jobs: %v", err)
+		}
+		names = append(names, job.JobName)
+	}
+	if len(names) != 7 || names[0] != "job-0" || names[6] != "job-6" {
+		t.Fatalf("unexpected jobs: %v", names)
+	}
+
+	// break 后不再继续拉取
+	n := 0
+	for _, err := range admin.Jobs(ctx, JobFilter{PageSize: 2}) {
+		if err != nil {
+			t.Fatalf("Jobs: %v", err)
+		}
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Fatalf("break should stop iteration, got %d", n)
+	}
+}
+
+func TestAdminClient_RunsFilterAndRetry(t *testing.T) {
+	srv, admin := newFakeAdmin(t)
+	ctx := waitCtx(t)
+	if _, err := admin.CreateJob(ctx, &pb.Job{JobName: "export"}); err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, err := admin.TriggerJob(ctx, "export", TriggerOptions{BizKey: fmt.Sprintf("b%d", i), Operator: "ops"}); err != nil {
+			t.Fatalf("TriggerJob: %v", err)
+		}
+	}
+
+	var runIDs []string
+	for run, err := range admin.Runs(ctx, RunFilter{JobName: "export", PageSize: 2}) {
+		if err != nil {
+			t.Fatalf("Runs: %v", err)
+		}
+		if run.TriggerType != pb.TriggerType_TRIGGER_TYPE_MANUAL {
+			t.Fatalf("trigger type got=%s", run.TriggerType)
+		}
+		runIDs = append(runIDs, run.RunId)
+	}
+	if len(runIDs) != 5 {
+		t.Fatalf("expect 5 runs, got %v", runIDs)
+	}
+
+	if err := admin.CancelRun(ctx, runIDs[0], "stop"); err != nil {
+		t.Fatalf("CancelRun: %v", err)
+	}
+	if err := admin.CancelRun(ctx, runIDs[0], "again"); !errors.Is(err, ErrRejected) {
+		t.Fatalf("cancel finished run err=%v", err)
+	}
+	newID, err := admin.RetryRun(ctx, runIDs[0])
+	if err != nil {
+		t.Fatalf("RetryRun: %v", err)
+	}
+	run, err := admin.GetRun(ctx, newID)
+	if err != nil || run.ParentRunId != runIDs[0] || run.RetryCount != 1 {
+		t.Fatalf("retried run=%v err=%v", run, err)
+	}
+	if got := len(srv.Runs()); got != 6 {
+		t.Fatalf("server runs got=%d", got)
+	}
+}
+
+func TestAdminClient_TypedErrors(t *testing.T) {
+	srv, admin := newFakeAdmin(t)
+	ctx := waitCtx(t)
+
+	_, err := admin.GetJob(ctx, "missing")
+	if !errors.Is(err, ErrNotFound) || status.Code(err) != codes.NotFound {
+		t.Fatalf("GetJob missing err=%v", err)
+	}
+	if _, err := admin.CreateJob(ctx, &pb.Job{JobName: "dup"}); err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	if _, err := admin.CreateJob(ctx, &pb.Job{JobName: "dup"}); !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("CreateJob dup err=%v", err)
+	}
+
+	// 其他应用的 job：进程内直接调用 fake 登记
+	if _, err := srv.CreateJob(context.Background(), &pb.CreateJobRequest{Job: &pb.Job{JobName: "foreign", AppName: "other-app"}}); err != nil {
+		t.Fatalf("seed foreign job: %v", err)
+	}
+	if _, err := admin.PauseJob(ctx, "foreign"); !errors.Is(err, ErrPermissionDenied) {
+		t.Fatalf("PauseJob foreign err=%v", err)
+	}
+	job, err := admin.PauseJob(ctx, "dup")
+	if err != nil || job.Status != "paused" {
+		t.Fatalf("PauseJob job=%v err=%v", job, err)
+	}
+
+	// 配置 JWT 后以 UI 用户身份操作，不受应用归属限制
+	ops, err := NewAdminClient(ctx, Config{
+		Endpoint: srv.Addr(), AppName: srv.AppName(), AppKey: srv.AppKey(), AppSecret: srv.AppSecret(),
+		AdminToken: srv.AdminToken(),
+	})
+	if err != nil {
+		t.Fatalf("NewAdminClient: %v", err)
+	}
+	defer ops.Close()
+	if _, err := ops.PauseJob(ctx, "foreign"); err != nil {
+		t.Fatalf("PauseJob foreign with JWT: %v", err)
+	}
+
+	// 签名错误 / JWT 错误 / token 获取失败均映射为 ErrPermissionDenied
+	for name, mutate := range map[string]func(*Config){
+		"wrong secret": func(c *Config) { c.AppSecret = "wrong" },
+		"wrong token":  func(c *Config) { c.AdminToken = "wrong" },
+		"source error": func(c *Config) {
+			c.AdminTokenSource = func(context.Context) (string, error) { return "", errors.New("expired") }
+		},
+	} {
+		cfg := Config{Endpoint: srv.Addr(), AppName: srv.AppName(), AppKey: srv.AppKey(), AppSecret: srv.AppSecret()}
+		mutate(&cfg)
+		bad, err := NewAdminClient(ctx, cfg)
+		if err != nil {
+			t.Fatalf("%s: NewAdminClient: %v", name, err)
+		}
+		defer bad.Close()
+		if _, _, err := bad.ListJobs(ctx, JobFilter{}, 1); !errors.Is(err, ErrPermissionDenied) {
+			t.Fatalf("%s: err=%v", name, err)
+		}
+	}
+}
+
+func TestJWTCredentials_MethodScope(t *testing.T) {
+	creds := jwtCredentials{token: "t0k"}
+	for method, want := range map[string]bool{
+		pb.SchedulerService_ListJobs_FullMethodName:    true,
+		pb.SchedulerService_RetryRun_FullMethodName:    true,
+		pb.AppService_ResetAppSecret_FullMethodName:    true,
+		pb.AlertService_ListAlertRules_FullMethodName:  true,
+		pb.SchedulerService_SubmitTask_FullMethodName:  false,
+		pb.SchedulerService_GetRun_FullMethodName:      true,
+		pb.SchedulerService_WatchRun_FullMethodName:    true,
+		pb.SchedulerService_SubmitTasks_FullMethodName: false,
+		pb.WorkerService_Connect_FullMethodName:        false,
+		pb.UserService_PasswordLogin_FullMethodName:    false,
+	} {
+		ctx := credentials.NewContextWithRequestInfo(context.Background(), credentials.RequestInfo{Method: method})
+		md, err := creds.GetRequestMetadata(ctx)
+		if err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		if got := md[mdAuthorization] == "Bearer t0k"; got != want {
+			t.Fatalf("%s: md=%v, want token=%v", method, md, want)
+		}
+	}
+}
+
+func TestPaginate_StopsOnErrorAndShortPage(t *testing.T) {
+	calls := 0
+	boom := errors.New("boom")
+	seq := paginate(context.Background(), 2, func(_ context.Context, page, _ int32) ([]int, int64, error) {
+		calls++
+		if page == 2 {
+			return nil, 0, boom
+		}
+		return []int{1, 2}, 10, nil
+	})
+	var got []int
+	var gotErr error
+	for v, err := range seq {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, v)
+	}
+	if !errors.Is(gotErr, boom) || len(got) != 2 || calls != 2 {
+		t.Fatalf("got=%v err=%v calls=%d", got, gotErr, calls)
+	}
+
+	calls = 0
+	for range paginate(context.Background(), 3, func(_ context.Context, _, _ int32) ([]int, int64, error) {
+		calls++
+		return []int{1}, 0, nil
+	}) {
+	}
+	if calls != 1 {
+		t.Fatalf("short page should end iteration, calls=%d", calls)
+	}
+}
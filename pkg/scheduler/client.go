@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sidchai/compkg/pkg/scheduler/internal/localsched"
 	pb "github.com/sidchai/compkg/proto/scheduler/v1"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 )
 
@@ -35,12 +37,28 @@ type Client struct {
 	schedCli  pb.SchedulerServiceClient
 
 	// handlers：jobName → HandlerFunc，Start 之前注册；Start 后只读
-	handlersMu sync.RWMutex
-	handlers   map[string]HandlerFunc
+	// middlewares 为 Use 注册的全局链，jobMiddlewares 为 RegisterHandler 传入的 per-job 链；
+	// chains 为套好两者的调用链，RegisterHandler / Use 时组装，派发时直接取用。同受 handlersMu 保护
+	handlersMu     sync.RWMutex
+	handlers       map[string]HandlerFunc
+	middlewares    []Middleware
+	jobMiddlewares map[string][]Middleware
+	chains         map[string]HandlerFunc
 
-	// 服务端协商后的实际心跳间隔（RegisterResponse.HeartbeatInterval），运行时由 stream 写入
+	// 服务端协商后的实际心跳间隔（RegisterResponse.HeartbeatInterval）与当前 leader（scheduler_leader），运行时由 stream 写入
 	heartbeatMu       sync.Mutex
 	negotiatedHbDelay time.Duration
+	schedulerLeader   string
+	// heartbeatNow 让当前 session 立即补发一次心跳（Drain 通知服务端）
+	heartbeatNow chan struct{}
+
+	// draining Drain 已调用：心跳 / 注册携带 draining，新的 Dispatch 一律拒收
+	draining atomic.Bool
+
+	// watchUnsupported 服务端未实现流式 WatchRun，之后的 WatchRun 直接轮询 GetRun
+	watchUnsupported atomic.Bool
+	// batchUnsupported 服务端未实现 SubmitTasks，之后的 SubmitBatch 逐条 SubmitTask
+	batchUnsupported atomic.Bool
 
 	// 运行时状态
 	startedMu sync.Mutex
@@ -53,22 +71,54 @@ type Client struct {
 	// inflight：保护优雅关闭时等待 handler 跑完
 	inflightWG sync.WaitGroup
 
-	// 并发限制 semaphore（buffered chan 实现）
-	concurrencySem chan struct{}
+	// runQueue 执行准入：进程级 MaxConcurrency + per-job 并发上限与等待队列
+	runQueue *runQueue
 
 	// stream 子 goroutine 退出信号，Stop 时等它收尾
 	streamDone chan struct{}
 
-	// cancels：run_id → handler ctx 的 cancel 函数；用于响应服务端 Cancel
+	// cancels：run_id → 执行中或排队中的任务；用于响应服务端 Cancel，DebugHandler 据此列出 inflight run
 	cancelsOnce sync.Once
 	cancelsMu   sync.Mutex
-	cancels     map[string]context.CancelFunc
+	cancels     map[string]*dispatchTask
+
+	// resultOutbox 待上报 JobResult 发件箱；handler 结果先入箱，再由当前 session 的 sender 发出
+	resultOutbox *resultOutbox
+
+	// progressBox 待发送的 JobProgress，按 run 合并最新一条
+	progressBox *progressBox
 
 	// localBuffer 本地兜底队列；仅当 cfg.LocalBufferEnabled=true 时非 nil
 	localBuffer *submitBuffer
 
 	// localSpool 磁盘二级兜底队列；仅当 LocalBufferEnabled 和 LocalBufferDiskSpillEnabled 同时为 true 时非 nil
 	localSpool *submitSpool
+
+	// clock 签名时钟相对服务端的偏移校正
+	clock *clockSkew
+
+	// offload 大 payload / output 的对象存储转存；未配置 PayloadOffloadOss 时为 nil
+	offload *payloadOffloader
+
+	// routing 多副本模式的副本解析与 leader 写路由；单副本 / 本地模式为 nil
+	routing *endpointRouting
+
+	// metrics Prometheus 指标，始终记录；metricsReg 为 Start 时注册到的 Registerer，未注册为 nil
+	metrics    *sdkMetrics
+	metricsReg prometheus.Registerer
+
+	// worker stream 连接状态（sessionSince 为进入当前状态的 unix 纳秒）与丢弃计数，供指标采集
+	sessionUp    atomic.Bool
+	sessionSince atomic.Int64
+	reconnects   atomic.Int64
+	droppedAcks  atomic.Int64
+	droppedTasks atomic.Int64
+
+	// recentErrors 最近的会话 / 派发 / handler 错误，供 DebugHandler 展示
+	recentErrors *errorRing
+
+	// local 本地模式的进程内调度引擎；Start 时创建，Stop 时关闭
+	local *localsched.Engine
 }
 
 // New 构造一个未启动的 Client；handler 注册完毕后调用 Start。
@@ -80,14 +130,27 @@ func New(cfg Config) (*Client, error) {
 	cli := &Client{
 		cfg:               cfg,
 		handlers:          make(map[string]HandlerFunc),
-		concurrencySem:    make(chan struct{}, cfg.MaxConcurrency),
+		jobMiddlewares:    make(map[string][]Middleware),
+		chains:            make(map[string]HandlerFunc),
+		runQueue:          newRunQueue(cfg.MaxConcurrency),
+		progressBox:       newProgressBox(),
 		negotiatedHbDelay: cfg.HeartbeatInterval,
+		heartbeatNow:      make(chan struct{}, 1),
+		recentErrors:      newErrorRing(debugRecentErrors),
+	}
+	cli.clock = newClockSkew(&cli.cfg)
+	cli.metrics = newSDKMetrics(cli)
+	cli.offload = newPayloadOffloader(&cli.cfg)
+	outbox, err := newResultOutbox(cfg.ResultOutboxCapacity, cfg.ResultOutboxDiskEnabled, cfg.ResultOutboxDir)
+	if err != nil {
+		return nil, err
 	}
+	cli.resultOutbox = outbox
 	if cfg.LocalBufferEnabled {
 		// 提前初始化，业务方在 Start 之前也能 EnqueueTask 进队
 		cli.localBuffer = newSubmitBuffer(cfg.LocalBufferCapacity)
 		if cfg.LocalBufferDiskSpillEnabled {
-			spool, err := newSubmitSpool(cfg.LocalBufferDiskSpillDir, cfg.LocalBufferDiskSpillMaxBytes)
+			spool, err := newSubmitSpool(cfg.LocalBufferDiskSpillDir, cfg.LocalBufferDiskSpillMaxBytes, newSpoolCodec(&cfg))
 			if err != nil {
 				return nil, err
 			}
@@ -99,17 +162,29 @@ func New(cfg Config) (*Client, error) {
 
 // RegisterHandler 注册一个 Job 的处理函数。必须在 Start 之前调用。
 //
-// 重复注册同一 jobName 会覆盖旧值。jobName 为空或 h 为 nil 直接 panic（属编码错误）。
-func (c *Client) RegisterHandler(jobName string, h HandlerFunc) {
+// opts 可混合传入 Middleware（仅作用于该 job，位于 Client.Use 全局 middleware 之内、h 之外）
+// 与 WithMaxConcurrency / WithQueue 等执行选项。
+// 重复注册同一 jobName 会覆盖旧值（含 per-job middleware 与并发选项）。jobName 为空、h 或 opts 中有 nil 直接 panic（属编码错误）。
+func (c *Client) RegisterHandler(jobName string, h HandlerFunc, opts ...HandlerOption) {
 	if jobName == "" {
 		panic("scheduler: RegisterHandler with empty jobName")
 	}
 	if h == nil {
 		panic("scheduler: RegisterHandler with nil handler")
 	}
+	var o handlerOptions
+	for _, opt := range opts {
+		if opt == nil {
+			panic("scheduler: RegisterHandler with nil option")
+		}
+		opt.applyHandler(&o)
+	}
 	c.handlersMu.Lock()
 	defer c.handlersMu.Unlock()
 	c.handlers[jobName] = h
+	c.jobMiddlewares[jobName] = o.middlewares
+	c.buildChainLocked(jobName)
+	c.runQueue.configure(jobName, o.maxConcurrency, o.queueSize)
 }
 
 // handlerNames 返回当前注册的所有 jobName，用于 RegisterRequest.HandlerJobs。
@@ -123,11 +198,18 @@ func (c *Client) handlerNames() []string {
 	return names
 }
 
-// lookupHandler 派发时根据 jobName 查 handler；未注册返回 nil。
+// buildChainLocked 组装 jobName 的调用链（全局 middleware 在外、per-job 在内）；调用方须持有 handlersMu 写锁。
+// middleware 工厂只在这里调用，其内部状态（计数器、限流器等）在多次派发间共享。
+func (c *Client) buildChainLocked(jobName string) {
+	h := chainMiddlewares(c.handlers[jobName], c.jobMiddlewares[jobName]...)
+	c.chains[jobName] = chainMiddlewares(h, c.middlewares...)
+}
+
+// lookupHandler 派发时根据 jobName 查 handler，返回已套好全局 + per-job middleware 的调用链；未注册返回 nil。
 func (c *Client) lookupHandler(jobName string) HandlerFunc {
 	c.handlersMu.RLock()
 	defer c.handlersMu.RUnlock()
-	return c.handlers[jobName]
+	return c.chains[jobName]
 }
 
 // Start 建立到 scheduler 的 gRPC 连接并启动后台 stream goroutine。
@@ -143,31 +225,18 @@ func (c *Client) Start(ctx context.Context) error {
 	c.started = true
 	c.startedMu.Unlock()
 
-	// 拨号；用 ctx 控制 DialTimeout
-	dialCtx, cancel := context.WithTimeout(ctx, c.cfg.DialTimeout)
-	defer cancel()
-	conn, err := grpc.DialContext(dialCtx, c.cfg.Endpoint,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithBlock(),
-		grpc.WithDefaultCallOptions(
-			grpc.MaxCallRecvMsgSize(c.cfg.MaxRecvMsgSizeMB*1024*1024),
-			grpc.MaxCallSendMsgSize(c.cfg.MaxSendMsgSizeMB*1024*1024),
-		),
-		grpc.WithKeepaliveParams(keepalive.ClientParameters{
-			Time:                30 * time.Second,
-			Timeout:             10 * time.Second,
-			PermitWithoutStream: true,
-		}),
-	)
+	conn, err := c.dialScheduler(ctx)
 	if err != nil {
 		c.startedMu.Lock()
 		c.started = false
 		c.startedMu.Unlock()
-		return fmt.Errorf("scheduler: dial %s: %w", c.cfg.Endpoint, err)
+		return fmt.Errorf("scheduler: dial %s: %w", c.cfg.endpointDesc(), err)
 	}
 	c.conn = conn
 	c.workerCli = pb.NewWorkerServiceClient(conn)
 	c.schedCli = pb.NewSchedulerServiceClient(conn)
+	c.setSessionState(false)
+	c.registerMetrics()
 
 	// 总 ctx：Stop 时 cancel
 	c.rootCtx, c.rootCancel = context.WithCancel(context.Background())
@@ -182,9 +251,59 @@ func (c *Client) Start(ctx context.Context) error {
 	return nil
 }
 
+// dial 按 cfg 拨号到 scheduler；用 ctx 控制 DialTimeout。
+//
+// 传输凭据见 transportCredentials（明文 / TLS / mTLS / 自定义）；所有 unary 调用经 hmacCredentials 自动在 metadata 中附带签名，
+// 管理面 RPC 另经 jwtCredentials 附带 JWT（已配置时），并附带 cfg.PerRPCCredentials；签名 ts 按 clock 校正，unary 响应的服务端时间回写 clock。
+// 多副本模式（routing 非 nil）经 endpointRouting 解析副本并轮询。Client 与 AdminClient 共用；extra 追加在默认选项之后。
+func dial(ctx context.Context, cfg *Config, clock *clockSkew, routing *endpointRouting, extra ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts, err := dialOptions(cfg, clock)
+	if err != nil {
+		return nil, err
+	}
+	target := cfg.Endpoint
+	if routing != nil {
+		target = routing.target()
+		opts = append(opts, routing.dialOptions()...)
+	}
+	dialCtx, cancel := context.WithTimeout(ctx, cfg.DialTimeout)
+	defer cancel()
+	opts = append(opts, grpc.WithBlock())
+	return grpc.DialContext(dialCtx, target, append(opts, extra...)...)
+}
+
+// dialOptions 到 scheduler 任一副本的公共拨号选项（凭据、时钟校正、消息大小、keepalive）。
+func dialOptions(cfg *Config, clock *clockSkew) ([]grpc.DialOption, error) {
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithPerRPCCredentials(hmacCredentials{appName: cfg.AppName, appKey: cfg.AppKey, appSecret: cfg.AppSecret, clock: clock}),
+		grpc.WithPerRPCCredentials(jwtCredentials{token: cfg.AdminToken, source: cfg.AdminTokenSource}),
+		grpc.WithChainUnaryInterceptor(clock.intercept),
+		grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(cfg.MaxRecvMsgSizeMB*1024*1024),
+			grpc.MaxCallSendMsgSize(cfg.MaxSendMsgSizeMB*1024*1024),
+		),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                30 * time.Second,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	}
+	for _, pc := range cfg.PerRPCCredentials {
+		opts = append(opts, grpc.WithPerRPCCredentials(pc))
+	}
+	return opts, nil
+}
+
 // Stop 优雅关闭：取消 stream + 等待 inflight handler 跑完 + 关闭 grpc.ClientConn。
+// 执行中的 handler 会收到 ctx 取消并上报 CANCELED；滚动发布等需要等 run 跑完的场景用 Drain。
 //
 // ctx 超时后强制返回（已派发但未完成的任务会被丢弃，不上报 result）。
+// stream 先于 handler 关闭，Stop 期间完成的 JobResult 留在发件箱；开启 ResultOutboxDiskEnabled 才能跨进程补报。
 // 重复调用安全：第二次起返回 nil。
 func (c *Client) Stop(ctx context.Context) error {
 	c.startedMu.Lock()
@@ -219,9 +338,13 @@ func (c *Client) Stop(ctx context.Context) error {
 	case <-ctx.Done():
 	}
 
-	// 4. 关闭连接
+	// 4. 关闭连接（本地模式随后停止进程内引擎）
 	if c.conn != nil {
 		_ = c.conn.Close()
 	}
+	c.routing.close()
+	c.closeLocal()
+	c.setSessionState(false)
+	c.unregisterMetrics()
 	return nil
 }
@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// mdServerTs 服务端在 unary 响应 header（失败时为 trailer）中返回的当前时间，unix 秒，与 RegisterResponse.server_ts 一致。
+const mdServerTs = "x-server-ts"
+
+// clockSkew 本地时钟相对服务端的偏移：从 RegisterResponse.server_ts 与 unary 响应的 x-server-ts 学习，
+// 签名时的 ts 按偏移校正，避免设备时钟漂移超出服务端 5min 签名窗口后所有请求鉴权失败。
+//
+// 服务端时间精度为秒，偏移变化不超过 1s 时视为抖动忽略。nil 或关闭校正时 now 即 time.Now。
+type clockSkew struct {
+	offset    atomic.Int64 // 秒：服务端时间 - 本地时间
+	warnAfter time.Duration
+	disabled  bool
+}
+
+func newClockSkew(cfg *Config) *clockSkew {
+	return &clockSkew{warnAfter: cfg.ClockSkewWarnThreshold, disabled: cfg.DisableClockSkewCompensation}
+}
+
+// now 按偏移校正后的当前时间，用于签名 ts。
+func (s *clockSkew) now() time.Time {
+	if s == nil || s.disabled {
+		return time.Now()
+	}
+	return time.Now().Add(time.Duration(s.offset.Load()) * time.Second)
+}
+
+// Offset 当前学到的偏移（服务端时间 - 本地时间）；关闭校正时仍返回观测值，便于排障。
+func (s *clockSkew) Offset() time.Duration {
+	if s == nil {
+		return 0
+	}
+	return time.Duration(s.offset.Load()) * time.Second
+}
+
+// observe 记录一次服务端时间（unix 秒）；serverTs <= 0 表示服务端未提供，忽略。
+func (s *clockSkew) observe(serverTs int64) {
+	if s == nil || serverTs <= 0 {
+		return
+	}
+	observed := serverTs - time.Now().Unix()
+	prev := s.offset.Load()
+	if d := observed - prev; d >= -1 && d <= 1 {
+		return
+	}
+	if !s.offset.CompareAndSwap(prev, observed) {
+		return
+	}
+	switch offset := time.Duration(observed) * time.Second; {
+	case offset.Abs() >= s.warnAfter:
+		logger.Warnf("[scheduler-sdk] local clock is off by %s from scheduler (server - local), signing with corrected time; check NTP", offset)
+	case (time.Duration(prev) * time.Second).Abs() >= s.warnAfter:
+		logger.Infof("[scheduler-sdk] clock offset from scheduler back to %s", offset)
+	}
+}
+
+// intercept unary 拦截器：从响应 header / trailer 读取 x-server-ts。鉴权失败（时钟超窗）的响应同样携带，
+// 学到偏移后业务的下一次调用（含 EnqueueTask 的后台重发）即可通过校验。
+func (s *clockSkew) intercept(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	for _, md := range []metadata.MD{header, trailer} {
+		if v := md.Get(mdServerTs); len(v) > 0 {
+			if ts, perr := strconv.ParseInt(v[0], 10, 64); perr == nil {
+				s.observe(ts)
+				break
+			}
+		}
+	}
+	return err
+}
+
+// ClockOffset 本地时钟相对 scheduler 的偏移（服务端时间 - 本地时间），从注册响应与 unary 响应头学习，精度 1s。
+// 签名按此校正（Config.DisableClockSkewCompensation 关闭）；超过 Config.ClockSkewWarnThreshold 时打 warn 日志。
+func (c *Client) ClockOffset() time.Duration {
+	return c.clock.Offset()
+}
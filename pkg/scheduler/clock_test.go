@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIntegration_ClockSkewLearnedFromRegister(t *testing.T) {
+	// 服务端快 10 分钟：首次注册因签名超窗被拒，学到偏移后重连成功
+	srv, c := startFakeClient(t, schedulertest.Options{ClockOffset: 10 * time.Minute}, nil, nil)
+	if off := c.ClockOffset(); (off - 10*time.Minute).Abs() > 2*time.Second {
+		t.Fatalf("ClockOffset=%s, want ~10m", off)
+	}
+	if _, _, err := c.SubmitTask(waitCtx(t), SubmitOptions{JobName: "device.push"}); err != nil {
+		t.Fatalf("SubmitTask with corrected clock: %v", err)
+	}
+	if len(srv.Runs()) != 1 {
+		t.Fatalf("runs=%d", len(srv.Runs()))
+	}
+}
+
+func TestIntegration_ClockSkewLearnedFromUnaryHeader(t *testing.T) {
+	srv := schedulertest.NewServer(schedulertest.Options{ClockOffset: -8 * time.Minute})
+	t.Cleanup(srv.Close)
+	// 不注册 worker（拿不到 server_ts），只经 unary 响应的 x-server-ts 学习偏移
+	newSubmit := func(disable bool) func() error {
+		cfg := Config{
+			Endpoint: srv.Addr(), AppName: srv.AppName(), AppKey: srv.AppKey(), AppSecret: srv.AppSecret(),
+			DisableClockSkewCompensation: disable,
+		}
+		cfg.applyDefaults()
+		clock := newClockSkew(&cfg)
+		conn, err := dial(waitCtx(t), &cfg, clock, nil)
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		t.Cleanup(func() { _ = conn.Close() })
+		cli := pb.NewSchedulerServiceClient(conn)
+		return func() error {
+			creds := newSignedCreds(cfg.AppKey, cfg.AppSecret, clock.now())
+			_, err := cli.SubmitTask(waitCtx(t), &pb.SubmitTaskRequest{
+				AppName: cfg.AppName, AppKey: cfg.AppKey, Nonce: creds.Nonce, Ts: creds.Ts, Signature: creds.Signature,
+				JobName: "device.push",
+			})
+			return err
+		}
+	}
+
+	submit := newSubmit(false)
+	if err := submit(); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("first call err=%v, want Unauthenticated before offset is known", err)
+	}
+	if err := submit(); err != nil {
+		t.Fatalf("second call with learned offset: %v", err)
+	}
+
+	disabled := newSubmit(true)
+	for range 2 {
+		if err := disabled(); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("compensation disabled: err=%v, want Unauthenticated", err)
+		}
+	}
+}
+
+func TestClockSkew_IgnoresJitter(t *testing.T) {
+	s := &clockSkew{warnAfter: 30 * time.Second}
+	s.observe(time.Now().Unix() + 1)
+	if s.Offset() != 0 {
+		t.Fatalf("1s jitter should be ignored, offset=%s", s.Offset())
+	}
+	s.observe(time.Now().Unix() - 120)
+	if off := s.Offset(); off > -119*time.Second || off < -121*time.Second {
+		t.Fatalf("offset=%s, want ~-2m", off)
+	}
+	if got := s.now().Sub(time.Now()); got > -119*time.Second || got < -121*time.Second {
+		t.Fatalf("now() not corrected: %s", got)
+	}
+	s.observe(0) // 服务端未提供
+	if s.Offset() == 0 {
+		t.Fatal("zero server_ts must be ignored")
+	}
+	var nilSkew *clockSkew
+	if d := time.Since(nilSkew.now()); d < 0 || d > time.Second {
+		t.Fatalf("nil clockSkew now off by %s", d)
+	}
+}
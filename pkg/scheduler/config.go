@@ -1,17 +1,27 @@
 package scheduler
 
 import (
+	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sidchai/compkg/pkg/compression"
+	"github.com/sidchai/compkg/pkg/serialization"
+	"github.com/sidchai/compkg/pkg/upload"
+	"google.golang.org/grpc/credentials"
 )
 
-// Config 是创建 Client 的参数。所有字段都有合理默认值，仅 Endpoint / AppName / AppKey / AppSecret 必填。
+// Config 是创建 Client 的参数。所有字段都有合理默认值，仅 Endpoint（或 Endpoints / EndpointResolver）/ AppName / AppKey / AppSecret 必填
+// （LocalMode 下均可省略）。
 type Config struct {
 	// === 必填 ===
 
-	// Endpoint scheduler 服务的 gRPC 地址，如 "scheduler.svc:9090"
+	// Endpoint scheduler 服务的 gRPC 地址，如 "scheduler.svc:9090"；配置 Endpoints / EndpointResolver 时可省略
 	Endpoint string
 
 	// AppName 与 sched_app.app_name 一致；服务端据此鉴权与限流
@@ -35,8 +45,8 @@ type Config struct {
 	// SDKVersion 业务方填自己的版本号，用于服务端兼容性检查
 	SDKVersion string
 
-	// MaxConcurrency worker 同时执行的最大任务数；超过时新 Dispatch 会被 Ack(accepted=false)
-	// 默认 50
+	// MaxConcurrency worker 同时执行的最大任务数；超过时新 Dispatch 进入该 job 的等待队列（WithQueue），
+	// 未开启队列或队列已满时 Ack(accepted=false)。默认 50
 	MaxConcurrency int
 
 	// DialTimeout grpc.Dial 超时；默认 5s
@@ -58,6 +68,122 @@ type Config struct {
 	MaxRecvMsgSizeMB int
 	MaxSendMsgSizeMB int
 
+	// PayloadCodec RegisterTypedHandler / SubmitTyped 使用的 pkg/serialization 编解码名称：
+	// CodecJSON（"json"）或 CodecSonic（"sonic"）；默认 "json"
+	PayloadCodec string
+
+	// === 多副本 ===
+
+	// Endpoints scheduler 多副本地址（host:port），与 Endpoint 合并为静态列表。配置后进入多副本模式：
+	// unary 调用在健康的副本间轮询、只读方法 Unavailable 时换副本重试，worker stream 断线后重连到其他副本，
+	// 管理类写操作优先发往 RegisterResponse.scheduler_leader（或响应头 x-scheduler-leader）指向的副本
+	Endpoints []string
+
+	// EndpointResolver 动态解析副本地址（DNSEndpoints、resolver/nacos 或自定义）；设置后忽略 Endpoint / Endpoints
+	EndpointResolver EndpointResolver
+
+	// EndpointRefreshInterval 多副本模式下重新解析副本地址的间隔；默认 30s
+	EndpointRefreshInterval time.Duration
+
+	// LeaderAddress 把 RegisterResponse.scheduler_leader / x-scheduler-leader 映射为副本地址（host:port），返回空串表示不做写路由。
+	// 默认：leader 为 "host:port" 时按副本地址完全匹配；为节点 ID（如 StatefulSet pod 名 "scheduler-1"）时
+	// 匹配主机名或其首段相同的唯一副本（"scheduler-1.scheduler-headless:9090"）。服务端节点 ID 与地址无关时配置此项
+	LeaderAddress func(leader string) string
+
+	// === 时钟校正 ===
+
+	// DisableClockSkewCompensation 关闭签名时钟校正。默认开启：SDK 从 RegisterResponse.server_ts 与 unary 响应头
+	// x-server-ts 学习本地时钟偏移（Client.ClockOffset），签名 ts 按服务端时间计算
+	DisableClockSkewCompensation bool
+
+	// ClockSkewWarnThreshold 时钟偏移超过该值时打 warn 日志；默认 30s
+	ClockSkewWarnThreshold time.Duration
+
+	// === 大负载转存 ===
+
+	// PayloadOffloadOss 已 NewClient 的对象存储实例（pkg/upload）。非 nil 时超过 PayloadOffloadThreshold 的提交 payload 与
+	// handler output 上传后以 OffloadRef 引用信封代替发送，接收方经 Job.LoadPayload / Client.ResolvePayload 取回。
+	// Oss 实现带可变状态，SDK 内部串行调用并在每次上传前重设目录；请为 Client 单独创建实例，勿与业务代码共用
+	PayloadOffloadOss upload.Oss
+
+	// PayloadOffloadThreshold 触发转存的字节数；默认 256KB
+	PayloadOffloadThreshold int
+
+	// PayloadOffloadCatalogue 转存对象的目录，实际为 {Catalogue}/{AppName}；默认 "scheduler-offload"
+	PayloadOffloadCatalogue string
+
+	// PayloadOffloadDir 下载转存对象的临时目录（读入内存后即删除）；默认 os.TempDir()/scheduler-offload
+	PayloadOffloadDir string
+
+	// PayloadOffloadDeleteOnComplete run 结束（成功、取消，或失败 / 超时且不再重试）、结果确认送达后由执行它的 worker 删除其转存的
+	// payload 对象。仅适用于每次提交独立上传的 payload：同一引用被多个 run 共用，或需要在 run 结束后 RetryRun 时不要开启。
+	// 默认 false。output 对象是 Run.Output 的内容，SDK 不删除；未删除的对象统一交给对象存储生命周期规则清理
+	PayloadOffloadDeleteOnComplete bool
+
+	// === 指标 ===
+
+	// MetricsRegisterer 非 nil 时 Start 在其上注册 SDK 的 Prometheus 指标（scheduler_sdk_*，常量标签 app），Stop 时注销
+	MetricsRegisterer prometheus.Registerer
+
+	// MetricsEnabled MetricsRegisterer 为空时注册到 pkg/metrics 的 registry（metrics.Init 开启 EnablePrometheus），
+	// 未初始化则注册到 prometheus.DefaultRegisterer。默认 false（只记录不注册）
+	MetricsEnabled bool
+
+	// === 管理面鉴权 ===
+
+	// AdminToken 可选，管理面 RPC（AdminClient / Client.Admin、SyncJobs 等）额外携带的 JWT，经 UserService 登录获得；
+	// 附带在 SchedulerService 除 SubmitTask / SubmitTasks 外的方法与 AppService / AlertService 上，以 "authorization: Bearer" 发送。
+	// 默认不配置：所有 unary RPC 以 AppName / AppKey / AppSecret 的 HMAC 签名鉴权，只能操作本应用的对象
+	AdminToken string
+
+	// AdminTokenSource 每次管理面调用时获取 JWT（如到期前自动续签）；配置后优先于 AdminToken，返回错误时该次调用失败
+	AdminTokenSource func(ctx context.Context) (string, error)
+
+	// === 传输安全 ===
+
+	// TLSEnabled 以 TLS 连接 scheduler；配置了 TLSCAFile / TLSCertFile / TLSConfig 时自动启用。
+	// 未配置 TLSCAFile 时用系统根证书校验服务端。默认 false（明文 gRPC）。
+	TLSEnabled bool
+
+	// TLSCAFile 校验服务端证书的 CA 文件（PEM，可含多张）
+	TLSCAFile string
+
+	// TLSCertFile / TLSKeyFile mTLS 客户端证书与私钥（PEM），须同时配置
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// TLSServerName 校验服务端证书（及 SNI）使用的主机名；默认取所连地址的主机部分
+	TLSServerName string
+
+	// TLSInsecureSkipVerify 不校验服务端证书，仅用于联调环境
+	TLSInsecureSkipVerify bool
+
+	// TLSReloadInterval 检查 CA / 证书文件更新的最小间隔：文件 mtime 变化后的下一次 TLS 握手（新建连接 / 重连）
+	// 使用新证书，证书轮换无需重启进程。默认 1m。
+	TLSReloadInterval time.Duration
+
+	// TLSConfig 高级 TLS 配置，克隆后作为基础配置，再叠加上述 TLS* 字段
+	TLSConfig *tls.Config
+
+	// TransportCredentials 自定义传输凭据（如服务网格提供的凭据）；与 TLS* 字段互斥
+	TransportCredentials credentials.TransportCredentials
+
+	// PerRPCCredentials 附加的每次调用凭据（如服务网格 / 网关要求的 token），作用于所有 RPC
+	PerRPCCredentials []credentials.PerRPCCredentials
+
+	// === JobResult 发件箱 ===
+
+	// ResultOutboxCapacity 未送达 JobResult 的最大缓存条数；超出时淘汰最旧结果（由服务端 timeout 兜底）。
+	// 默认 1024。
+	ResultOutboxCapacity int
+
+	// ResultOutboxDiskEnabled 未送达的 JobResult 同时落盘到 ResultOutboxDir，进程重启后在首个 session 重放。默认 false。
+	ResultOutboxDiskEnabled bool
+
+	// ResultOutboxDir 发件箱落盘目录；ResultOutboxDiskEnabled 时必填。须在进程重启后保持不变（如挂载卷上按实例固定的路径），
+	// 且不与同机其他 worker 进程共用，否则会重放彼此的结果
+	ResultOutboxDir string
+
 	// === 本地兜底 buffer（仅 EnqueueTask 使用）===
 
 	// LocalBufferEnabled 启用内存兜底队列。开启后可调用 EnqueueTask；
@@ -86,10 +212,39 @@ type Config struct {
 	// LocalBufferDiskSpillMaxBytes 磁盘 spill 总大小上限；默认 100MB。
 	// 超限时优先淘汰最旧任务，避免 SDK 兜底队列无限占满磁盘。
 	LocalBufferDiskSpillMaxBytes int64
+
+	// LocalBufferDiskSpillCompression 磁盘 spill 记录的压缩算法，取 pkg/compression 注册名（内置 "gzip"）；为空不压缩。
+	LocalBufferDiskSpillCompression string
+
+	// LocalBufferDiskSpillKey 磁盘 spill 记录的 AES-GCM 加密密钥（16 字节 AES-128，可用 encrypt.ParseEncryptKey 解析 Base64 配置）；
+	// 为空不加密。更换密钥后旧密钥写入的记录无法解密，加载时隔离为 .corrupt 文件
+	LocalBufferDiskSpillKey []byte
+
+	// === 本地模式 ===
+
+	// LocalMode 不连接 scheduler 服务，改由进程内引擎调度（见 NewLocal）：job 的 cron / fixed_rate / one_time 触发、
+	// misfire_policy、retry_max / retry_backoff 重试均在本进程完成。Endpoint / AppKey / AppSecret 被忽略，
+	// AppName 默认 "local"，LocalBufferEnabled 强制开启以便 EnqueueTask 可用。默认 false。
+	LocalMode bool
+
+	// LocalHistoryFile 本地模式下 job 与 run 的持久化文件；为空时只保存在内存，进程退出即丢失。
+	LocalHistoryFile string
+
+	// LocalHistoryMaxRuns 本地模式保留的已结束 run 条数，超出淘汰最早结束的；默认 10000。
+	LocalHistoryMaxRuns int
 }
 
 // applyDefaults 填充未设置的字段；调用方再 Validate() 即可。
 func (c *Config) applyDefaults() {
+	if c.LocalMode {
+		if c.AppName == "" {
+			c.AppName = "local"
+		}
+		if c.Endpoint == "" {
+			c.Endpoint = localEndpoint
+		}
+		c.LocalBufferEnabled = true
+	}
 	if c.MaxConcurrency <= 0 {
 		c.MaxConcurrency = 50
 	}
@@ -114,6 +269,30 @@ func (c *Config) applyDefaults() {
 	if c.MaxSendMsgSizeMB <= 0 {
 		c.MaxSendMsgSizeMB = 4
 	}
+	if c.EndpointRefreshInterval <= 0 {
+		c.EndpointRefreshInterval = 30 * time.Second
+	}
+	if c.PayloadOffloadThreshold <= 0 {
+		c.PayloadOffloadThreshold = defaultOffloadThreshold
+	}
+	if c.PayloadOffloadCatalogue == "" {
+		c.PayloadOffloadCatalogue = defaultOffloadCatalogue
+	}
+	if c.PayloadOffloadDir == "" {
+		c.PayloadOffloadDir = filepath.Join(os.TempDir(), defaultOffloadCatalogue)
+	}
+	if c.ClockSkewWarnThreshold <= 0 {
+		c.ClockSkewWarnThreshold = 30 * time.Second
+	}
+	if c.TLSReloadInterval <= 0 {
+		c.TLSReloadInterval = time.Minute
+	}
+	if c.PayloadCodec == "" {
+		c.PayloadCodec = CodecJSON
+	}
+	if c.ResultOutboxCapacity <= 0 {
+		c.ResultOutboxCapacity = 1024
+	}
 	if c.LocalBufferCapacity <= 0 {
 		c.LocalBufferCapacity = 1024
 	}
@@ -126,6 +305,9 @@ func (c *Config) applyDefaults() {
 	if c.LocalBufferDiskSpillMaxBytes <= 0 {
 		c.LocalBufferDiskSpillMaxBytes = defaultDiskSpillMaxBytes
 	}
+	if c.LocalHistoryMaxRuns <= 0 {
+		c.LocalHistoryMaxRuns = 10000
+	}
 	if c.InstanceID == "" {
 		if h, err := os.Hostname(); err == nil {
 			c.InstanceID = h
@@ -140,20 +322,50 @@ func (c *Config) applyDefaults() {
 
 // Validate 校验必填字段；applyDefaults 后调用。
 func (c *Config) Validate() error {
-	if c.Endpoint == "" {
-		return errors.New("scheduler: Config.Endpoint required")
+	if !c.LocalMode {
+		if c.Endpoint == "" && !c.multiEndpoint() {
+			return errors.New("scheduler: Config.Endpoint required")
+		}
+		if c.AppKey == "" {
+			return errors.New("scheduler: Config.AppKey required")
+		}
+		if c.AppSecret == "" {
+			return errors.New("scheduler: Config.AppSecret required")
+		}
 	}
 	if c.AppName == "" {
 		return errors.New("scheduler: Config.AppName required")
 	}
-	if c.AppKey == "" {
-		return errors.New("scheduler: Config.AppKey required")
-	}
-	if c.AppSecret == "" {
-		return errors.New("scheduler: Config.AppSecret required")
-	}
 	if c.ReconnectMinBackoff > c.ReconnectMaxBackoff {
 		return errors.New("scheduler: ReconnectMinBackoff must <= ReconnectMaxBackoff")
 	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("scheduler: TLSCertFile and TLSKeyFile must be set together")
+	}
+	if c.TransportCredentials != nil && c.tlsEnabled() {
+		return errors.New("scheduler: TransportCredentials and TLS* options are mutually exclusive")
+	}
+	for _, e := range c.Endpoints {
+		if e == "" {
+			return errors.New("scheduler: empty address in Config.Endpoints")
+		}
+	}
+	if c.ResultOutboxDiskEnabled && c.ResultOutboxDir == "" {
+		return errors.New("scheduler: Config.ResultOutboxDir required when ResultOutboxDiskEnabled")
+	}
+	for _, pc := range c.PerRPCCredentials {
+		if pc == nil {
+			return errors.New("scheduler: nil PerRPCCredentials")
+		}
+	}
+	if c.LocalBufferDiskSpillCompression != "" && compression.GetCompression(c.LocalBufferDiskSpillCompression) == nil {
+		return fmt.Errorf("scheduler: unknown Config.LocalBufferDiskSpillCompression %q", c.LocalBufferDiskSpillCompression)
+	}
+	if n := len(c.LocalBufferDiskSpillKey); n != 0 && n != 16 {
+		return fmt.Errorf("scheduler: Config.LocalBufferDiskSpillKey must be 16 bytes (AES-128), got %d", n)
+	}
+	if serialization.GetSerialization(c.PayloadCodec) == nil {
+		return fmt.Errorf("scheduler: unknown Config.PayloadCodec %q", c.PayloadCodec)
+	}
 	return nil
 }
@@ -28,6 +28,9 @@ func TestConfig_ApplyDefaults(t *testing.T) {
 	if c.SubmitTimeout != 5*time.Second {
 		t.Errorf("SubmitTimeout default got=%s want=5s", c.SubmitTimeout)
 	}
+	if c.PayloadCodec != CodecJSON {
+		t.Errorf("PayloadCodec default got=%s want=%s", c.PayloadCodec, CodecJSON)
+	}
 	if c.LocalBufferDiskSpillMaxBytes != defaultDiskSpillMaxBytes {
 		t.Errorf("LocalBufferDiskSpillMaxBytes default got=%d want=%d", c.LocalBufferDiskSpillMaxBytes, defaultDiskSpillMaxBytes)
 	}
@@ -56,6 +59,8 @@ func TestConfig_Validate(t *testing.T) {
 				ReconnectMinBackoff: 10 * time.Second, ReconnectMaxBackoff: 1 * time.Second},
 			true,
 		},
+		{"落盘发件箱缺目录", Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", ResultOutboxDiskEnabled: true}, true},
+		{"落盘发件箱ok", Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", ResultOutboxDiskEnabled: true, ResultOutboxDir: "/data/outbox"}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,275 @@
+// Package cron 解析 iot-scheduler 的 cron 表达式（pb.Job.cron_expr + timezone）并计算触发时间，
+// 供提交前校验 job 定义、预览之后的触发时间，以及本地模式（LocalMode）的进程内调度使用。
+//
+//	s, err := cron.ParseInZone("0 0 8 * * mon-fri", "Asia/Shanghai")
+//	if err != nil { return err }
+//	for _, t := range s.NextN(time.Now(), 5) { fmt.Println(t) }
+//
+// 语法：6 段（秒 分 时 日 月 周）或标准 5 段（分 时 日 月 周，秒取 0），以及 @daily / @hourly 等描述符。
+// 每段支持 *、?、n、a-b、*/s、a-b/s、n/s 与逗号列表，月 / 周支持英文缩写，周字段 0 与 7 都表示周日；
+// 日、周都受限时按 OR 匹配（与 crontab 一致）。
+//
+// 夏令时：跳过的时刻不会触发；回拨重复的时段内，小时字段固定（如 0 30 1 * * *）的表达式只在第一次经过时触发一次，
+// 小时字段为 * 的表达式（如每小时、每分钟）按实际经过的时间在两次经过中各触发（与 Quartz / Spring 一致）。
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalid cron 表达式或时区非法。
+var ErrInvalid = errors.New("cron: invalid expression")
+
+// Schedule 解析后的 cron 表达式；各字段为允许取值的位集（bit i 表示取值 i）。并发只读安全。
+type Schedule struct {
+	sec, min, hour, dom, month, dow uint64
+	// domAny / dowAny 日 / 周字段为 * 或 ?：两者都受限时按 OR 匹配（与 crontab 一致），否则按 AND
+	domAny, dowAny bool
+	// hourAll 小时字段覆盖全部 24 小时：夏令时回拨重复的时段内仍按实际时间触发，否则只在第一次经过时触发
+	hourAll bool
+	loc     *time.Location
+}
+
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	secondField = cronField{min: 0, max: 59}
+	minuteField = cronField{min: 0, max: 59}
+	hourField   = cronField{min: 0, max: 23}
+	domField    = cronField{min: 1, max: 31}
+	monthField  = cronField{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 周字段允许 0-7，0 与 7 都表示周日
+	dowField = cronField{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Parse 解析 cron 表达式，按 loc 的墙上时间计算；loc 为 nil 时按 time.Local。
+// 语法错误返回包装 ErrInvalid 的错误，指明出错的段。
+func Parse(expr string, loc *time.Location) (*Schedule, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	spec := strings.TrimSpace(expr)
+	if d, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = d
+	}
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("%w %q: want 5 or 6 fields, got %d", ErrInvalid, expr, len(fields))
+	}
+	s := &Schedule{loc: loc}
+	var err error
+	parse := func(i int, f cronField) uint64 {
+		if err != nil {
+			return 0
+		}
+		var bits uint64
+		bits, err = parseCronField(fields[i], f)
+		if err != nil {
+			err = fmt.Errorf("%w %q: field %d: %w", ErrInvalid, expr, i+1, err)
+		}
+		return bits
+	}
+	s.sec = parse(0, secondField)
+	s.min = parse(1, minuteField)
+	s.hour = parse(2, hourField)
+	s.dom = parse(3, domField)
+	s.month = parse(4, monthField)
+	s.dow = parse(5, dowField)
+	if err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domAny = fields[3] == "*" || fields[3] == "?"
+	s.dowAny = fields[5] == "*" || fields[5] == "?"
+	s.hourAll = s.hour == 1<<24-1
+	return s, nil
+}
+
+// ParseInZone 按 IANA 时区名（pb.Job.timezone，如 Asia/Shanghai）解析；timezone 为空时按 time.Local。
+func ParseInZone(expr, timezone string) (*Schedule, error) {
+	loc, err := LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(expr, loc)
+}
+
+// LoadLocation 按 pb.Job.timezone 的约定加载时区：空串为 time.Local，非法时返回包装 ErrInvalid 的错误。
+func LoadLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: timezone %q: %w", ErrInvalid, timezone, err)
+	}
+	return loc, nil
+}
+
+// Validate 只校验表达式与时区，不保留解析结果。
+func Validate(expr, timezone string) error {
+	_, err := ParseInZone(expr, timezone)
+	return err
+}
+
+func parseCronField(expr string, f cronField) (uint64, error) {
+	var bits uint64
+	for part := range strings.SplitSeq(expr, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		lo, hi := f.min, f.max
+		switch {
+		case rng == "*" || rng == "?":
+		case strings.Contains(rng, "-"):
+			a, b, _ := strings.Cut(rng, "-")
+			var err error
+			if lo, err = f.value(a); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(b); err != nil {
+				return 0, err
+			}
+		default:
+			v, err := f.value(rng)
+			if err != nil {
+				return 0, err
+			}
+			lo, hi = v, v
+			if hasStep {
+				// n/s 表示从 n 开始到最大值
+				hi = f.max
+			}
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("range %q: start after end", part)
+		}
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d,%d]", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Location 计算触发时间所用的时区。
+func (s *Schedule) Location() *time.Location {
+	return s.loc
+}
+
+// Next 返回严格晚于 after 的下一次触发时间（位于 Location 时区）；5 年内无匹配（如 2 月 30 日）返回零值。
+// 按 loc 的墙上时间匹配：夏令时跳过的时刻不会触发；回拨重复的时段内，小时字段固定的表达式只匹配第一次经过，
+// 小时字段为 * 的按实际经过的时间各匹配一次。
+func (s *Schedule) Next(after time.Time) time.Time {
+	t := after.In(s.loc).Truncate(time.Second).Add(time.Second)
+	limit := t.Year() + 5
+	for t.Year() <= limit {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc))
+		case !s.dayMatches(t):
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc))
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			// 按绝对时间前进到下一个整点：time.Date 会把夏令时跳过的整点规整回前一小时
+			t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+		case !s.hourAll && repeatedWallTime(t):
+			// 回拨后第二次经过同一墙上时刻：固定小时的任务已在第一次经过时触发，跳到下一个整点
+			t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+		case s.min&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute - time.Duration(t.Second())*time.Second)
+		case s.sec&(1<<uint(t.Second())) == 0:
+			t = t.Add(time.Second)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// NextN 返回 after 之后的 n 次触发时间（升序）；不足 n 次（5 年内再无匹配）时只返回已找到的部分。
+func (s *Schedule) NextN(after time.Time, n int) []time.Time {
+	var times []time.Time
+	for range n {
+		after = s.Next(after)
+		if after.IsZero() {
+			break
+		}
+		times = append(times, after)
+	}
+	return times
+}
+
+// forward 返回 time.Date 算出的 next；夏令时使其不晚于 t（目标零点不存在）时改为前进到下一个整点。
+func forward(t, next time.Time) time.Time {
+	if next.After(t) {
+		return next
+	}
+	return t.Add(time.Hour - time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+}
+
+// repeatedWallTime t 的墙上时间是否在夏令时回拨前已出现过（t 处于重复时段的第二次经过）。
+// 以 3 小时前的偏移为回拨前偏移：覆盖常见的 30 分钟 / 1 小时回拨。
+func repeatedWallTime(t time.Time) bool {
+	_, off := t.Zone()
+	_, prev := t.Add(-3 * time.Hour).Zone()
+	if prev <= off {
+		return false
+	}
+	_, earlier := t.Add(-time.Duration(prev-off) * time.Second).Zone()
+	return earlier == prev
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
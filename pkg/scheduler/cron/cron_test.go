@@ -0,0 +1,125 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse_Next(t *testing.T) {
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	newYork, _ := time.LoadLocation("America/New_York")
+	cases := []struct {
+		expr  string
+		loc   *time.Location
+		after time.Time
+		want  time.Time
+	}{
+		{"0 30 8 * * *", shanghai, time.Date(2026, 1, 1, 9, 0, 0, 0, shanghai), time.Date(2026, 1, 2, 8, 30, 0, 0, shanghai)},
+		{"*/15 * * * *", time.UTC, time.Date(2026, 1, 1, 10, 7, 30, 0, time.UTC), time.Date(2026, 1, 1, 10, 15, 0, 0, time.UTC)},
+		{"*/20 * * * * ?", time.UTC, time.Date(2026, 1, 1, 10, 0, 40, 0, time.UTC), time.Date(2026, 1, 1, 10, 1, 0, 0, time.UTC)},
+		{"@daily", time.UTC, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"0 0 9 * * mon-fri", time.UTC, time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC), time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)},
+		// 日、周都受限时按 OR：2026-02-06 是周五
+		{"0 0 0 13 * FRI", time.UTC, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 6, 0, 0, 0, 0, time.UTC)},
+		{"0 0 0 1 jan,jul *", time.UTC, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)},
+		// 夏令时开始当天 02:30 不存在，跳到次日
+		{"0 30 2 * * *", newYork, time.Date(2026, 3, 7, 3, 0, 0, 0, newYork), time.Date(2026, 3, 9, 2, 30, 0, 0, newYork)},
+		{"0 0 0 30 2 *", time.UTC, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, tc := range cases {
+		s, err := Parse(tc.expr, tc.loc)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tc.expr, err)
+		}
+		if got := s.Next(tc.after); !got.Equal(tc.want) {
+			t.Errorf("%q next after %s = %s, want %s", tc.expr, tc.after, got, tc.want)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * *", "61 * * * *", "5-1 * * * *", "*/0 * * * *", "* * * * foo", "* * * * * * *"} {
+		if _, err := Parse(expr, time.UTC); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%q) err=%v, want ErrInvalid", expr, err)
+		}
+	}
+}
+
+func TestParseInZone(t *testing.T) {
+	s, err := ParseInZone("0 0 8 * * *", "Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("ParseInZone: %v", err)
+	}
+	if s.Location().String() != "Asia/Shanghai" {
+		t.Fatalf("loc=%s", s.Location())
+	}
+	// 2026-01-01 00:30 UTC 为上海 08:30，下一次是次日上海 08:00
+	if got := s.Next(time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC)); !got.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("next=%s", got)
+	}
+	if s, err := ParseInZone("@hourly", ""); err != nil || s.Location() != time.Local {
+		t.Fatalf("empty timezone: loc=%v err=%v", s, err)
+	}
+	if err := Validate("0 0 8 * * *", "Mars/Olympus"); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("err=%v, want ErrInvalid", err)
+	}
+}
+
+func TestSchedule_NextN(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	cases := []struct {
+		expr  string
+		after time.Time
+		n     int
+		want  []time.Time
+	}{
+		{"0 0 9 * * mon-fri", time.Date(2026, 1, 2, 10, 0, 0, 0, newYork), 3, []time.Time{
+			time.Date(2026, 1, 5, 9, 0, 0, 0, newYork),
+			time.Date(2026, 1, 6, 9, 0, 0, 0, newYork),
+			time.Date(2026, 1, 7, 9, 0, 0, 0, newYork),
+		}},
+		// 夏令时结束（11-01 02:00 回拨到 01:00）：01:30 出现两次，固定小时的任务只在第一次触发
+		{"0 30 1 * * *", time.Date(2026, 10, 31, 12, 0, 0, 0, newYork), 3, []time.Time{
+			time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC),
+			time.Date(2026, 11, 2, 6, 30, 0, 0, time.UTC),
+			time.Date(2026, 11, 3, 6, 30, 0, 0, time.UTC),
+		}},
+		{"0 */20 1 * * *", time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC), 2, []time.Time{
+			time.Date(2026, 11, 1, 5, 40, 0, 0, time.UTC),
+			time.Date(2026, 11, 2, 6, 0, 0, 0, time.UTC),
+		}},
+		// 小时字段为 *：重复的 01:30 按实际经过的时间各触发一次
+		{"0 30 * * * *", time.Date(2026, 11, 1, 0, 45, 0, 0, newYork), 3, []time.Time{
+			time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC),
+			time.Date(2026, 11, 1, 6, 30, 0, 0, time.UTC),
+			time.Date(2026, 11, 1, 7, 30, 0, 0, time.UTC),
+		}},
+		// 夏令时开始（03-08 02:00 跳到 03:00）：每小时任务当天少一次
+		{"0 0 * * * *", time.Date(2026, 3, 8, 0, 30, 0, 0, newYork), 3, []time.Time{
+			time.Date(2026, 3, 8, 6, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 8, 7, 0, 0, 0, time.UTC),
+			time.Date(2026, 3, 8, 8, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 0 29 2 *", time.Date(2026, 1, 1, 0, 0, 0, 0, newYork), 2, []time.Time{
+			time.Date(2028, 2, 29, 0, 0, 0, 0, newYork),
+			time.Date(2032, 2, 29, 0, 0, 0, 0, newYork),
+		}},
+		{"0 0 0 30 2 *", time.Date(2026, 1, 1, 0, 0, 0, 0, newYork), 3, nil},
+	}
+	for _, tc := range cases {
+		s, err := Parse(tc.expr, newYork)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tc.expr, err)
+		}
+		got := s.NextN(tc.after, tc.n)
+		if len(got) != len(tc.want) {
+			t.Fatalf("%q NextN=%v, want %v", tc.expr, got, tc.want)
+		}
+		for i := range got {
+			if !got[i].Equal(tc.want[i]) {
+				t.Errorf("%q NextN[%d]=%s, want %s", tc.expr, i, got[i], tc.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+)
+
+// debugRecentErrors DebugHandler 保留的最近错误条数。
+const debugRecentErrors = 50
+
+// 最近错误的来源。
+const (
+	errSourceSession  = "session"  // worker stream 断开 / 注册失败
+	errSourceDispatch = "dispatch" // 派发被拒收（未注册 handler、并发或队列已满）
+	errSourceHandler  = "handler"  // handler 返回错误 / 超时 / panic
+)
+
+// debugError 一条最近错误。
+type debugError struct {
+	Time   time.Time `json:"time"`
+	Source string    `json:"source"`
+	RunID  string    `json:"run_id,omitempty"`
+	Job    string    `json:"job,omitempty"`
+	Error  string    `json:"error"`
+}
+
+// errorRing 定长环形缓冲，超出容量覆盖最旧的记录。
+type errorRing struct {
+	mu   sync.Mutex
+	buf  []debugError
+	next int
+}
+
+func newErrorRing(capacity int) *errorRing {
+	return &errorRing{buf: make([]debugError, 0, capacity)}
+}
+
+func (r *errorRing) add(e debugError) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.buf) < cap(r.buf) {
+		r.buf = append(r.buf, e)
+		return
+	}
+	r.buf[r.next] = e
+	r.next = (r.next + 1) % len(r.buf)
+}
+
+// list 按时间从新到旧返回。
+func (r *errorRing) list() []debugError {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]debugError, 0, len(r.buf))
+	out = append(out, r.buf[r.next:]...)
+	out = append(out, r.buf[:r.next]...)
+	slices.Reverse(out)
+	return out
+}
+
+// recordError 记录一条最近错误，供 DebugHandler 展示。
+func (c *Client) recordError(source, runID, job, msg string) {
+	c.recentErrors.add(debugError{Time: time.Now(), Source: source, RunID: runID, Job: job, Error: msg})
+}
+
+// debugState DebugHandler GET 返回的 JSON。
+type debugState struct {
+	App       string           `json:"app"`
+	WorkerID  string           `json:"worker_id"`
+	Local     bool             `json:"local_mode"`
+	Draining  bool             `json:"draining"`
+	Session   debugSession     `json:"session"`
+	Handlers  []debugHandler   `json:"handlers"`
+	Inflight  []debugRun       `json:"inflight"`
+	Running   int              `json:"running"`
+	Queued    int              `json:"queued"`
+	Cancels   int              `json:"cancel_map_size"`
+	Results   int              `json:"pending_results"`
+	Buffer    *debugBuffer     `json:"local_buffer,omitempty"`
+	Spool     *debugSpool      `json:"disk_spool,omitempty"`
+	Dropped   map[string]int64 `json:"dropped"`
+	Errors    []debugError     `json:"recent_errors"`
+	Generated time.Time        `json:"generated_at"`
+}
+
+type debugSession struct {
+	Connected         bool      `json:"connected"`
+	Since             time.Time `json:"since"`
+	Reconnects        int64     `json:"reconnects"`
+	Leader            string    `json:"scheduler_leader"`
+	HeartbeatInterval string    `json:"heartbeat_interval"`
+	ClockOffsetMs     int64     `json:"clock_offset_ms"`
+}
+
+type debugHandler struct {
+	Job            string `json:"job"`
+	MaxConcurrency int    `json:"max_concurrency"`
+	QueueSize      int    `json:"queue_size"`
+	Running        int    `json:"running"`
+	Queued         int    `json:"queued"`
+}
+
+type debugRun struct {
+	RunID      string     `json:"run_id"`
+	Job        string     `json:"job"`
+	BizKey     string     `json:"biz_key,omitempty"`
+	State      string     `json:"state"` // running / queued
+	AcceptedAt time.Time  `json:"accepted_at"`
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	// ElapsedMs running 为执行时长，queued 为已排队时长
+	ElapsedMs int64 `json:"elapsed_ms"`
+}
+
+type debugBuffer struct {
+	Tasks    int `json:"tasks"`
+	Capacity int `json:"capacity"`
+}
+
+type debugSpool struct {
+	Tasks     int    `json:"tasks"`
+	Bytes     int64  `json:"bytes"`
+	MaxBytes  int64  `json:"max_bytes"`
+	Evicted   int64  `json:"evicted"`
+	Corrupted int64  `json:"corrupted"`
+	Dir       string `json:"dir"`
+}
+
+// debugCancelResponse DebugHandler POST 的返回。
+type debugCancelResponse struct {
+	RunID    string `json:"run_id"`
+	Canceled bool   `json:"canceled"`
+	Error    string `json:"error,omitempty"`
+}
+
+// DebugHandler 返回本 worker 的调试端点，挂到服务已有的内部管理端口即可，路径不限：
+//
+//	mux.Handle("/debug/scheduler", client.DebugHandler())
+//
+// GET 以 JSON 返回已注册 handler、会话状态（连接、scheduler leader、协商后的心跳间隔）、执行中 / 排队中的 run
+// （run_id / job / 开始时间 / 已耗时）、取消表大小、本地 buffer 与磁盘 spool 状态以及最近的错误。
+// POST run_id=<id>（query 或表单，可选 reason）取消本进程内执行或排队中的 run：handler ctx 被取消，
+// 结果按 CANCELED 上报；run 不在本进程时返回 404。
+//
+// 端点不做鉴权，只应暴露在内网管理端口上。
+func (c *Client) DebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			writeDebugJSON(w, http.StatusOK, c.debugState())
+		case http.MethodPost:
+			runID, reason := r.FormValue("run_id"), r.FormValue("reason")
+			if runID == "" {
+				writeDebugJSON(w, http.StatusBadRequest, debugCancelResponse{Error: "run_id required"})
+				return
+			}
+			if !c.cancelLocal(runID, reason) {
+				writeDebugJSON(w, http.StatusNotFound, debugCancelResponse{RunID: runID, Error: "run not inflight on this worker"})
+				return
+			}
+			writeDebugJSON(w, http.StatusOK, debugCancelResponse{RunID: runID, Canceled: true})
+		default:
+			w.Header().Set("Allow", "GET, HEAD, POST")
+			writeDebugJSON(w, http.StatusMethodNotAllowed, debugCancelResponse{Error: "method not allowed"})
+		}
+	})
+}
+
+// cancelLocal 取消本进程内执行或排队中的 run；不存在返回 false。
+func (c *Client) cancelLocal(runID, reason string) bool {
+	c.cancelsMu.Lock()
+	t, ok := c.cancels[runID]
+	c.cancelsMu.Unlock()
+	if !ok {
+		return false
+	}
+	logger.Infof("[scheduler-sdk] cancel run_id=%s via debug handler reason=%s", runID, reason)
+	c.cancelTask(t)
+	return true
+}
+
+func (c *Client) debugState() *debugState {
+	now := time.Now()
+	up, since := c.sessionState()
+	c.heartbeatMu.Lock()
+	leader := c.schedulerLeader
+	c.heartbeatMu.Unlock()
+
+	s := &debugState{
+		App:      c.cfg.AppName,
+		WorkerID: c.cfg.WorkerID,
+		Local:    c.cfg.LocalMode,
+		Draining: c.draining.Load(),
+		Session: debugSession{
+			Connected:         up,
+			Since:             since,
+			Reconnects:        c.reconnects.Load(),
+			Leader:            leader,
+			HeartbeatInterval: c.heartbeatDelay().String(),
+			ClockOffsetMs:     c.ClockOffset().Milliseconds(),
+		},
+		Results:   c.PendingResults(),
+		Errors:    c.recentErrors.list(),
+		Generated: now,
+		Dropped: map[string]int64{
+			dropAck:    c.droppedAcks.Load(),
+			dropResult: c.resultOutbox.dropped.Load(),
+			dropTask:   c.droppedTasks.Load(),
+		},
+	}
+	if since.UnixNano() == 0 {
+		s.Session.Since = time.Time{}
+	}
+
+	names := c.handlerNames()
+	sort.Strings(names)
+	s.Handlers = make([]debugHandler, 0, len(names))
+	for _, name := range names {
+		h := debugHandler{Job: name}
+		h.MaxConcurrency, h.QueueSize, h.Running, h.Queued = c.runQueue.laneStats(name)
+		s.Handlers = append(s.Handlers, h)
+	}
+	s.Running, s.Queued = c.runQueue.stats()
+
+	c.cancelsMu.Lock()
+	s.Cancels = len(c.cancels)
+	s.Inflight = make([]debugRun, 0, len(c.cancels))
+	for _, t := range c.cancels {
+		run := debugRun{RunID: t.d.RunId, Job: t.d.JobName, BizKey: t.d.BizKey, State: "queued", AcceptedAt: t.acceptedAt}
+		run.ElapsedMs = now.Sub(t.acceptedAt).Milliseconds()
+		if ns := t.startedAt.Load(); ns > 0 {
+			started := time.Unix(0, ns)
+			run.State, run.StartedAt, run.ElapsedMs = "running", &started, now.Sub(started).Milliseconds()
+		}
+		s.Inflight = append(s.Inflight, run)
+	}
+	c.cancelsMu.Unlock()
+	sort.Slice(s.Inflight, func(i, j int) bool {
+		if !s.Inflight[i].AcceptedAt.Equal(s.Inflight[j].AcceptedAt) {
+			return s.Inflight[i].AcceptedAt.Before(s.Inflight[j].AcceptedAt)
+		}
+		return s.Inflight[i].RunID < s.Inflight[j].RunID
+	})
+
+	if c.localBuffer != nil {
+		s.Buffer = &debugBuffer{Tasks: c.localBuffer.Len(), Capacity: c.localBuffer.cap}
+		s.Dropped[dropTask] += c.localBuffer.dropped.Load()
+	}
+	if c.localSpool != nil {
+		s.Spool = &debugSpool{
+			Tasks:     c.localSpool.len(),
+			Bytes:     c.localSpool.bytes(),
+			MaxBytes:  c.localSpool.maxBytes,
+			Evicted:   c.localSpool.evicted.Load(),
+			Corrupted: c.localSpool.corrupted.Load(),
+			Dir:       c.localSpool.dir,
+		}
+		s.Dropped[dropTask] += s.Spool.Evicted + s.Spool.Corrupted
+	}
+	return s
+}
+
+func writeDebugJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v) // 客户端断开等写失败无需处理
+}
@@ -0,0 +1,154 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+func getDebugState(t *testing.T, url string) debugState {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	defer resp.Body.Close()
+	var s debugState
+	if err := json.NewDecoder(resp.Body).Decode(&s); err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET status=%d err=%v", resp.StatusCode, err)
+	}
+	return s
+}
+
+func postDebugCancel(t *testing.T, url, runID string) int {
+	t.Helper()
+	resp, err := http.PostForm(url, map[string][]string{"run_id": {runID}, "reason": {"stuck"}})
+	if err != nil {
+		t.Fatalf("POST: %v", err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestDebugHandler(t *testing.T) {
+	started := make(chan string, 2)
+	srv, c := startFakeClientWith(t, schedulertest.Options{Leader: "sched-1:9090", HeartbeatIntervalSec: 3}, func(cfg *Config) {
+		cfg.LocalBufferEnabled = true
+		cfg.LocalBufferCapacity = 8
+	}, func(c *Client) {
+		c.RegisterHandler("block", func(ctx context.Context, job *Job) (string, error) {
+			started <- job.RunID
+			<-ctx.Done()
+			return "", ctx.Err()
+		}, WithMaxConcurrency(1), WithQueue(4))
+		c.RegisterHandler("echo", func(_ context.Context, job *Job) (string, error) { return string(job.Payload), nil })
+	})
+	ctx := waitCtx(t)
+	if err := srv.WaitRegisters(ctx, 1); err != nil {
+		t.Fatalf("WaitRegisters: %v", err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/debug/scheduler", c.DebugHandler())
+	hs := httptest.NewServer(mux)
+	defer hs.Close()
+	endpoint := hs.URL + "/debug/scheduler"
+
+	for _, d := range []*pb.Dispatch{
+		{RunId: "r1", JobName: "block", BizKey: "device-1"},
+		{RunId: "r2", JobName: "block"},
+		{RunId: "r3", JobName: "missing"},
+	} {
+		if err := srv.Dispatch(d); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+		if _, err := srv.WaitAck(ctx, d.RunId); err != nil {
+			t.Fatalf("WaitAck %s: %v", d.RunId, err)
+		}
+	}
+	if id := <-started; id != "r1" {
+		t.Fatalf("started %s, want r1", id)
+	}
+
+	s := getDebugState(t, endpoint)
+	if !s.Session.Connected || s.Session.Leader != "sched-1:9090" || s.Session.HeartbeatInterval != "3s" {
+		t.Fatalf("session=%+v", s.Session)
+	}
+	if len(s.Handlers) != 2 || s.Handlers[0].Job != "block" || s.Handlers[0].MaxConcurrency != 1 || s.Handlers[0].QueueSize != 4 ||
+		s.Handlers[0].Running != 1 || s.Handlers[0].Queued != 1 {
+		t.Fatalf("handlers=%+v", s.Handlers)
+	}
+	if s.Cancels != 2 || len(s.Inflight) != 2 || s.Running != 1 || s.Queued != 1 {
+		t.Fatalf("cancels=%d running=%d queued=%d inflight=%+v", s.Cancels, s.Running, s.Queued, s.Inflight)
+	}
+	if r := s.Inflight[0]; r.RunID != "r1" || r.State != "running" || r.StartedAt == nil || r.BizKey != "device-1" {
+		t.Fatalf("inflight[0]=%+v", r)
+	}
+	if r := s.Inflight[1]; r.RunID != "r2" || r.State != "queued" || r.StartedAt != nil {
+		t.Fatalf("inflight[1]=%+v", r)
+	}
+	if s.Buffer == nil || s.Buffer.Capacity != 8 || s.Spool != nil {
+		t.Fatalf("buffer=%+v spool=%+v", s.Buffer, s.Spool)
+	}
+	if len(s.Errors) != 1 || s.Errors[0].Source != errSourceDispatch || s.Errors[0].RunID != "r3" {
+		t.Fatalf("recent errors=%+v", s.Errors)
+	}
+
+	// 取消排队中的 run 与执行中的 run，均按 CANCELED 上报
+	for _, id := range []string{"r2", "r1"} {
+		if code := postDebugCancel(t, endpoint, id); code != http.StatusOK {
+			t.Fatalf("cancel %s status=%d", id, code)
+		}
+		res, err := srv.WaitResult(ctx, id)
+		if err != nil {
+			t.Fatalf("WaitResult %s: %v", id, err)
+		}
+		if res.Status != pb.RunStatus_RUN_STATUS_CANCELED {
+			t.Fatalf("%s status=%v", id, res.Status)
+		}
+	}
+	if code := postDebugCancel(t, endpoint, "r1"); code != http.StatusNotFound {
+		t.Fatalf("cancel finished run status=%d, want 404", code)
+	}
+	if code := postDebugCancel(t, endpoint, ""); code != http.StatusBadRequest {
+		t.Fatalf("cancel without run_id status=%d, want 400", code)
+	}
+	req, _ := http.NewRequest(http.MethodDelete, endpoint, nil)
+	if resp, err := http.DefaultClient.Do(req); err != nil || resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("DELETE resp=%v err=%v", resp, err)
+	} else {
+		resp.Body.Close()
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		s = getDebugState(t, endpoint)
+		if s.Cancels == 0 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if s.Cancels != 0 || len(s.Inflight) != 0 || s.Errors[0].Source != errSourceHandler || !strings.Contains(s.Errors[0].Error, "canceled") {
+		t.Fatalf("after cancel: cancels=%d inflight=%+v errors=%+v", s.Cancels, s.Inflight, s.Errors)
+	}
+}
+
+func TestErrorRing(t *testing.T) {
+	r := newErrorRing(3)
+	for i := range 5 {
+		r.add(debugError{RunID: string(rune('a' + i))})
+	}
+	var got []string
+	for _, e := range r.list() {
+		got = append(got, e.RunID)
+	}
+	if strings.Join(got, ",") != "e,d,c" {
+		t.Fatalf("list=%v, want newest first", got)
+	}
+}
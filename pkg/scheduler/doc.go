@@ -3,10 +3,10 @@
 // 业务方通过本 SDK 完成：
 //  1. 注册 Job Handler（业务代码）
 //  2. 与 scheduler 服务建立长连接（双向流）：处理服务端派发 + 上报执行结果 + 维持心跳
-//  3. 主动提交一次性任务（SubmitTask，trigger_type=api）
+//  3. 主动提交一次性任务（SubmitTask，trigger_type=api，支持延迟 / 定时执行）
 //
 // 与服务端的协议（compkg/proto/scheduler/v1）：
-//   - Connect 双向流：首条 RegisterRequest → 收 RegisterResponse → 循环 Heartbeat/Ack/Result ←→ Dispatch/Cancel/Reload
+//   - Connect 双向流：首条 RegisterRequest → 收 RegisterResponse → 循环 Heartbeat/Ack/Progress/Result ←→ Dispatch/Cancel/Reload
 //   - SubmitTask 单次 unary：业务方主动入队
 //
 // 签名算法（与 internal/sigverify 保持一致）：
@@ -39,6 +39,12 @@
 //	    Payload: []byte(`{"record_id":123}`),
 //	})
 //
+// 本地模式：NewLocal（或 Config.LocalMode=true）不连接 scheduler 服务，在进程内按 job 配置触发、重试并可选落盘历史，
+// API 与远程模式一致，见 README「本地模式」。
+//
+// 多副本：配置 Config.Endpoints 或 EndpointResolver（StaticEndpoints / DNSEndpoints / resolver/nacos）后，
+// unary 调用在健康副本间轮询并换副本重试，worker stream 断线后重连到其他副本，管理类写操作优先发往 leader。
+//
 // 线程安全：
 //   - 所有公共方法均可并发调用。
 //   - RegisterHandler 必须在 Start 之前完成，否则 RegisterRequest.HandlerJobs 不会包含该 job。
@@ -0,0 +1,143 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+)
+
+// drainPollInterval Drain 检查 inflight run 与待发送结果的间隔。
+const drainPollInterval = 20 * time.Millisecond
+
+// drainStopGrace Drain 的 ctx 到期后，等待被取消的 run 上报 CANCELED 并 Stop 的额外时间。
+const drainStopGrace = 3 * time.Second
+
+// Drain 优雅下线，用于滚动发布：
+//  1. 通知服务端本 worker 进入 draining（立即补发一次 Heartbeat.draining=true），服务端不再派发新任务；
+//     期间仍到达的 Dispatch 以 Ack(accepted=false, reason="worker draining") 拒收，由服务端改派其他 worker
+//  2. 等待执行中与本地排队中的 run 全部结束，且结果与进度都已写入 stream
+//  3. Stop 关闭连接
+//
+// ctx 到期时不再等待：取消未完成的 run，在 drainStopGrace 内等其 CANCELED 结果写入 stream 后 Stop
+// （仍未送达的仅开启 ResultOutboxDiskEnabled 时可跨进程补报），返回包装 ctx.Err() 的错误。未 Start 时返回 ErrNotStarted。
+func (c *Client) Drain(ctx context.Context) error {
+	c.startedMu.Lock()
+	started := c.started
+	c.startedMu.Unlock()
+	if !started {
+		return ErrNotStarted
+	}
+	if !c.draining.Swap(true) {
+		logger.Infof("[scheduler-sdk] draining worker=%s", c.cfg.WorkerID)
+	}
+	select {
+	case c.heartbeatNow <- struct{}{}:
+	default:
+	}
+
+	waitErr := c.waitIdle(ctx)
+	stopCtx := ctx
+	if waitErr != nil {
+		inflight, queued := c.runQueue.stats()
+		logger.Warnf("[scheduler-sdk] drain deadline exceeded, cancel inflight=%d queued=%d", inflight, queued)
+		// ctx 已到期：另起短暂的宽限 ctx，在 session 仍存活时取消剩余 run 并等 CANCELED 结果发出，再 Stop
+		var cancel context.CancelFunc
+		stopCtx, cancel = context.WithTimeout(context.WithoutCancel(ctx), drainStopGrace)
+		defer cancel()
+		c.cancelAll()
+		_ = c.waitIdle(stopCtx)
+	}
+	if err := c.Stop(stopCtx); err != nil {
+		return err
+	}
+	if waitErr != nil {
+		return fmt.Errorf("scheduler: drain: %w", waitErr)
+	}
+	return nil
+}
+
+// Draining 是否已调用 Drain。
+func (c *Client) Draining() bool {
+	return c.draining.Load()
+}
+
+// cancelAll 取消本进程内全部执行中与排队中的 run。
+func (c *Client) cancelAll() {
+	c.cancelsMu.Lock()
+	tasks := make([]*dispatchTask, 0, len(c.cancels))
+	for _, t := range c.cancels {
+		tasks = append(tasks, t)
+	}
+	c.cancelsMu.Unlock()
+	for _, t := range tasks {
+		c.cancelTask(t)
+	}
+}
+
+// waitIdle 阻塞直到没有 inflight / 排队中的 run，且发件箱与进度中没有未写入 stream 的消息。
+func (c *Client) waitIdle(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		c.inflightWG.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for len(c.resultOutbox.pending()) > 0 || c.progressBox.len() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// DrainOnSignal 收到 sigs（默认 SIGTERM、SIGINT）时以 timeout 为上限执行 Drain；必须在 Start 之后调用。
+//
+// 返回的 channel 在 Drain 结束后收到其结果并关闭；Client 先被 Stop / Drain 时不再监听信号，直接关闭 channel。
+// timeout 应小于 Kubernetes terminationGracePeriodSeconds，为 Stop 与进程退出留出余量：
+//
+//	if err := c.Start(ctx); err != nil { return err }
+//	drained := c.DrainOnSignal(25 * time.Second)
+//	...
+//	if err := <-drained; err != nil {
+//	    log.Printf("drain: %v", err)
+//	}
+func (c *Client) DrainOnSignal(timeout time.Duration, sigs ...os.Signal) <-chan error {
+	if len(sigs) == 0 {
+		sigs = []os.Signal{syscall.SIGTERM, os.Interrupt}
+	}
+	out := make(chan error, 1)
+	root := c.rootCtx
+	if root == nil {
+		close(out)
+		return out
+	}
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, sigs...)
+	go func() {
+		defer close(out)
+		defer signal.Stop(sigCh)
+		select {
+		case sig := <-sigCh:
+			logger.Infof("[scheduler-sdk] received %s, draining (timeout %s)", sig, timeout)
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			out <- c.Drain(ctx)
+		case <-root.Done():
+		}
+	}()
+	return out
+}
@@ -0,0 +1,105 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+func TestIntegration_DrainWaitsForInflightRun(t *testing.T) {
+	h := newBlockingHandler("r1")
+	srv, c := startFakeClient(t, schedulertest.Options{}, nil, map[string]HandlerFunc{"export": h.handle})
+	ctx := waitCtx(t)
+
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "export"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	h.waitStart(t, "r1")
+
+	drained := make(chan error, 1)
+	go func() { drained <- c.Drain(ctx) }()
+	if err := srv.WaitDraining(ctx); err != nil {
+		t.Fatalf("server never saw draining: %v", err)
+	}
+	if !c.Draining() {
+		t.Fatal("Draining() = false after Drain")
+	}
+
+	// 服务端感知前到达的派发被拒收
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r2", JobName: "export"}); err != nil {
+		t.Fatalf("Dispatch r2: %v", err)
+	}
+	ack, err := srv.WaitAck(ctx, "r2")
+	if err != nil || ack.Accepted || ack.Reason != "worker draining" {
+		t.Fatalf("r2 ack=%v err=%v", ack, err)
+	}
+
+	select {
+	case err := <-drained:
+		t.Fatalf("Drain returned before inflight run finished: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(h.release["r1"])
+	if err := <-drained; err != nil {
+		t.Fatalf("Drain: %v", err)
+	}
+	res, err := srv.WaitResult(ctx, "r1")
+	if err != nil || res.Status != pb.RunStatus_RUN_STATUS_SUCCESS {
+		t.Fatalf("r1 res=%v err=%v", res, err)
+	}
+	if err := c.Drain(ctx); !errors.Is(err, ErrNotStarted) {
+		t.Fatalf("second Drain err=%v, want ErrNotStarted", err)
+	}
+}
+
+func TestIntegration_DrainDeadlineCancelsRemainingRuns(t *testing.T) {
+	h := newBlockingHandler("r1")
+	srv, c := startFakeClient(t, schedulertest.Options{}, nil, map[string]HandlerFunc{"export": h.handle})
+
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "export"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	h.waitStart(t, "r1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if err := c.Drain(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Drain err=%v, want DeadlineExceeded", err)
+	}
+	// ctx 已到期，CANCELED 仍须在 Stop 前送达服务端
+	res, err := srv.WaitResult(waitCtx(t), "r1")
+	if err != nil || res.Status != pb.RunStatus_RUN_STATUS_CANCELED {
+		t.Fatalf("unfinished run res=%v err=%v, want CANCELED", res, err)
+	}
+}
+
+func TestIntegration_DrainOnSignal(t *testing.T) {
+	_, c := startFakeClient(t, schedulertest.Options{}, nil, map[string]HandlerFunc{
+		"noop": func(context.Context, *Job) (string, error) { return "", nil },
+	})
+	drained := c.DrainOnSignal(time.Second, os.Interrupt)
+
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatalf("FindProcess: %v", err)
+	}
+	if err := p.Signal(os.Interrupt); err != nil {
+		t.Skipf("signal not supported: %v", err)
+	}
+	select {
+	case err := <-drained:
+		if err != nil {
+			t.Fatalf("Drain: %v", err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("DrainOnSignal did not drain")
+	}
+	if !c.Draining() {
+		t.Fatal("client not draining after signal")
+	}
+}
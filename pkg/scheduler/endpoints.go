@@ -0,0 +1,457 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	_ "google.golang.org/grpc/health" // 注册客户端健康检查，配合 serviceConfig.healthCheckConfig
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+)
+
+// EndpointResolver 解析 scheduler 副本地址列表（"host:port"）。多副本模式下 Client 启动时解析一次，
+// 之后按 Config.EndpointRefreshInterval 及连接失败时刷新；返回错误或空列表时沿用上一次结果。
+type EndpointResolver interface {
+	Resolve(ctx context.Context) ([]string, error)
+}
+
+// EndpointResolverFunc 函数形式的 EndpointResolver。
+type EndpointResolverFunc func(ctx context.Context) ([]string, error)
+
+// Resolve 实现 EndpointResolver。
+func (f EndpointResolverFunc) Resolve(ctx context.Context) ([]string, error) { return f(ctx) }
+
+// StaticEndpoints 固定的副本地址列表；Config.Endpoints 即按此解析。
+func StaticEndpoints(addrs ...string) EndpointResolver {
+	list := slices.Clone(addrs)
+	return EndpointResolverFunc(func(context.Context) ([]string, error) { return list, nil })
+}
+
+// DNSEndpoints 按 DNS 解析 hostPort（如 "iot-scheduler-headless.prod:9090"）的全部 A / AAAA 记录，
+// 每个 IP 一个副本，适用于 k8s headless service。
+func DNSEndpoints(hostPort string) EndpointResolver {
+	return EndpointResolverFunc(func(ctx context.Context) ([]string, error) {
+		host, port, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler: dns endpoints %q: %w", hostPort, err)
+		}
+		ips, err := net.DefaultResolver.LookupHost(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler: dns endpoints %q: %w", hostPort, err)
+		}
+		addrs := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			addrs = append(addrs, net.JoinHostPort(ip, port))
+		}
+		return addrs, nil
+	})
+}
+
+// endpointScheme 多副本模式的 gRPC target scheme；resolver 经 grpc.WithResolvers 按连接注册，不污染全局。
+const endpointScheme = "scheduler-sdk"
+
+// minResolveInterval 连接失败触发的 ResolveNow 之间的最小间隔，避免副本全部不可达时高频解析。
+const minResolveInterval = time.Second
+
+// multiEndpoint 是否为多副本模式：配置了 Endpoints 或 EndpointResolver。
+func (c *Config) multiEndpoint() bool {
+	return !c.LocalMode && (c.EndpointResolver != nil || len(c.Endpoints) > 0)
+}
+
+// endpointResolver 多副本模式的解析器：EndpointResolver 优先，否则为 Endpoint + Endpoints 的静态列表。
+func (c *Config) endpointResolver() EndpointResolver {
+	if c.EndpointResolver != nil {
+		return c.EndpointResolver
+	}
+	var addrs []string
+	if c.Endpoint != "" {
+		addrs = append(addrs, c.Endpoint)
+	}
+	for _, a := range c.Endpoints {
+		if !slices.Contains(addrs, a) {
+			addrs = append(addrs, a)
+		}
+	}
+	return StaticEndpoints(addrs...)
+}
+
+// endpointDesc 日志 / 错误中展示的连接目标。
+func (c *Config) endpointDesc() string {
+	switch {
+	case c.EndpointResolver != nil:
+		return "EndpointResolver"
+	case len(c.Endpoints) > 0:
+		addrs, _ := c.endpointResolver().Resolve(context.Background())
+		return strings.Join(addrs, ",")
+	default:
+		return c.Endpoint
+	}
+}
+
+// endpointServiceConfig 多副本连接的默认 service config：round_robin 只向 READY 且健康检查通过的副本发请求
+// （服务端未实现 grpc.health.v1 时视为健康）。只有只读方法在 Unavailable 时自动换副本重试：写操作（CreateJob /
+// TriggerJob / SubmitTask / CancelRun 等）可能已被副本受理后才断开，重试会重复执行，交给调用方按业务判断。
+const endpointServiceConfig = `{
+  "loadBalancingConfig": [{"round_robin": {}}],
+  "healthCheckConfig": {"serviceName": ""},
+  "methodConfig": [{
+    "name": [
+      {"service": "scheduler.v1.SchedulerService", "method": "GetRun"},
+      {"service": "scheduler.v1.SchedulerService", "method": "WatchRun"},
+      {"service": "scheduler.v1.SchedulerService", "method": "ListRuns"},
+      {"service": "scheduler.v1.SchedulerService", "method": "GetJob"},
+      {"service": "scheduler.v1.SchedulerService", "method": "ListJobs"},
+      {"service": "scheduler.v1.SchedulerService", "method": "ListWorkers"},
+      {"service": "scheduler.v1.SchedulerService", "method": "GetDashboard"},
+      {"service": "scheduler.v1.SchedulerService", "method": "ListPendingChanges"},
+      {"service": "scheduler.v1.AppService", "method": "GetApp"},
+      {"service": "scheduler.v1.AppService", "method": "ListApps"},
+      {"service": "scheduler.v1.AlertService", "method": "GetAlertRule"},
+      {"service": "scheduler.v1.AlertService", "method": "ListAlertRules"},
+      {"service": "scheduler.v1.AlertService", "method": "GetAlertChannel"},
+      {"service": "scheduler.v1.AlertService", "method": "ListAlertChannels"},
+      {"service": "scheduler.v1.AlertService", "method": "ListAlertBindings"},
+      {"service": "scheduler.v1.AlertService", "method": "ListAlertEvents"}
+    ],
+    "retryPolicy": {
+      "maxAttempts": 3,
+      "initialBackoff": "0.1s",
+      "maxBackoff": "1s",
+      "backoffMultiplier": 2,
+      "retryableStatusCodes": ["UNAVAILABLE"]
+    }
+  }]
+}`
+
+// mdSchedulerLeader 服务端在 unary 响应 header / trailer 中携带的当前 leader（取值同 RegisterResponse.scheduler_leader），
+// 供未建立 worker stream 的 AdminClient 发现 leader。
+const mdSchedulerLeader = "x-scheduler-leader"
+
+// endpointRouting 多副本连接的路由状态：副本地址解析（grpc resolver）与 leader 写路由。
+// 单副本模式下为 nil，方法均可安全调用。
+type endpointRouting struct {
+	cfg      *Config
+	clock    *clockSkew
+	resolver EndpointResolver
+	interval time.Duration
+
+	// addrs 最近一次成功解析的副本地址
+	addrs atomic.Pointer[[]string]
+
+	mu         sync.Mutex
+	leader     string // RegisterResponse.scheduler_leader 或 unary 响应头 x-scheduler-leader
+	leaderAddr string
+	leaderConn *grpc.ClientConn
+	closed     bool
+}
+
+func newEndpointRouting(cfg *Config, clock *clockSkew) *endpointRouting {
+	if !cfg.multiEndpoint() {
+		return nil
+	}
+	return &endpointRouting{cfg: cfg, clock: clock, resolver: cfg.endpointResolver(), interval: cfg.EndpointRefreshInterval}
+}
+
+// target 拨号使用的 gRPC target；单副本模式为 Config.Endpoint。
+func (r *endpointRouting) target() string {
+	if r == nil {
+		return ""
+	}
+	return endpointScheme + ":///" + r.cfg.AppName
+}
+
+// dialOptions 多副本连接额外的拨号选项：按连接注册的 resolver、round_robin + 健康检查、leader 写路由拦截器。
+func (r *endpointRouting) dialOptions() []grpc.DialOption {
+	if r == nil {
+		return nil
+	}
+	return []grpc.DialOption{
+		grpc.WithResolvers(r),
+		grpc.WithDefaultServiceConfig(endpointServiceConfig),
+		grpc.WithChainUnaryInterceptor(r.intercept),
+	}
+}
+
+// endpoints 最近一次解析到的副本地址。
+func (r *endpointRouting) endpoints() []string {
+	if r == nil {
+		return nil
+	}
+	if p := r.addrs.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// Scheme 实现 resolver.Builder。
+func (r *endpointRouting) Scheme() string { return endpointScheme }
+
+// Build 实现 resolver.Builder：启动后台解析循环。
+func (r *endpointRouting) Build(_ resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &endpointWatcher{
+		routing: r,
+		cc:      cc,
+		ctx:     ctx,
+		cancel:  cancel,
+		now:     make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	go w.run()
+	return w, nil
+}
+
+// endpointWatcher 一条连接上的解析循环。
+type endpointWatcher struct {
+	routing *endpointRouting
+	cc      resolver.ClientConn
+	ctx     context.Context
+	cancel  context.CancelFunc
+	now     chan struct{}
+	done    chan struct{}
+}
+
+// ResolveNow 连接失败时由 gRPC 调用，触发一次提前解析（受 minResolveInterval 限制）。
+func (w *endpointWatcher) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case w.now <- struct{}{}:
+	default:
+	}
+}
+
+// Close 停止解析循环。
+func (w *endpointWatcher) Close() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *endpointWatcher) run() {
+	defer close(w.done)
+	var last []string
+	for {
+		resolved := time.Now()
+		addrs, err := w.resolve()
+		switch {
+		case err != nil && last == nil:
+			w.cc.ReportError(err)
+		case err != nil:
+			logger.Warnf("[scheduler-sdk] resolve endpoints failed, keep %v: %v", last, err)
+		case !slices.Equal(addrs, last):
+			last = addrs
+			w.routing.addrs.Store(&addrs)
+			if err := w.cc.UpdateState(resolver.State{Endpoints: resolverEndpoints(addrs)}); err != nil {
+				logger.Warnf("[scheduler-sdk] update endpoints %v: %v", addrs, err)
+			}
+		}
+
+		timer := time.NewTimer(w.routing.interval)
+		select {
+		case <-w.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		case <-w.now:
+			timer.Stop()
+			if wait := minResolveInterval - time.Since(resolved); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-w.ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// resolve 调用 EndpointResolver，结果排序去重；空列表视为错误。
+func (w *endpointWatcher) resolve() ([]string, error) {
+	ctx, cancel := context.WithTimeout(w.ctx, w.routing.cfg.DialTimeout)
+	defer cancel()
+	addrs, err := w.routing.resolver.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addrs = slices.Compact(slices.Sorted(slices.Values(addrs)))
+	if len(addrs) > 0 && addrs[0] == "" {
+		addrs = addrs[1:]
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("scheduler: no scheduler endpoints resolved")
+	}
+	return addrs, nil
+}
+
+// resolverEndpoints 每个副本一个 resolver.Endpoint；ServerName 取主机部分，TLS 按副本各自的地址校验证书。
+func resolverEndpoints(addrs []string) []resolver.Endpoint {
+	eps := make([]resolver.Endpoint, 0, len(addrs))
+	for _, a := range addrs {
+		addr := resolver.Address{Addr: a}
+		if host, _, err := net.SplitHostPort(a); err == nil {
+			addr.ServerName = host
+		}
+		eps = append(eps, resolver.Endpoint{Addresses: []resolver.Address{addr}})
+	}
+	return eps
+}
+
+// setLeader 记录当前 leader（RegisterResponse.scheduler_leader 或响应头 x-scheduler-leader）；
+// 经 leaderAddrLocked 对应到已解析的副本地址时用于写路由。
+func (r *endpointRouting) setLeader(leader string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.leader = leader
+}
+
+// intercept 管理类写操作优先发往 leader 副本，免去 follower 转发；leader 不可用时回落到 round_robin。
+// 所有 unary 响应的 x-scheduler-leader 回写 leader：AdminClient 没有 worker stream，靠它发现与跟随 leader 切换。
+func (r *endpointRouting) intercept(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	opts = append(opts, grpc.Header(&header), grpc.Trailer(&trailer))
+	err := r.invoke(ctx, method, req, reply, cc, invoker, opts...)
+	for _, md := range []metadata.MD{header, trailer} {
+		if v := md.Get(mdSchedulerLeader); len(v) > 0 && v[0] != "" {
+			r.setLeader(v[0])
+			break
+		}
+	}
+	return err
+}
+
+func (r *endpointRouting) invoke(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if !adminWriteMethods[method] {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	conn := r.leaderConnection()
+	if conn == nil || conn.GetState() == connectivity.TransientFailure {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	err := conn.Invoke(ctx, method, req, reply, opts...)
+	if status.Code(err) == codes.Unavailable && ctx.Err() == nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	return err
+}
+
+// leaderConnection 返回到 leader 副本的连接（按需建立，leader 变化时替换）；leader 未知或对应不到副本时返回 nil。
+func (r *endpointRouting) leaderConnection() *grpc.ClientConn {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed || r.leader == "" {
+		return nil
+	}
+	addr := r.leaderAddrLocked()
+	if addr == "" {
+		return nil
+	}
+	if r.leaderConn != nil && r.leaderAddr == addr {
+		return r.leaderConn
+	}
+	opts, err := dialOptions(r.cfg, r.clock)
+	if err != nil {
+		return nil
+	}
+	conn, err := grpc.NewClient("passthrough:///"+addr, opts...)
+	if err != nil {
+		logger.Warnf("[scheduler-sdk] dial leader %s (%s): %v", r.leader, addr, err)
+		return nil
+	}
+	if r.leaderConn != nil {
+		_ = r.leaderConn.Close()
+	}
+	r.leaderConn, r.leaderAddr = conn, addr
+	return conn
+}
+
+// leaderAddrLocked 把 scheduler_leader 对应到已解析的副本地址：Config.LeaderAddress 优先；否则 "host:port" 完全匹配，
+// 或按节点 ID 匹配主机名 / 主机名首段唯一相同的副本。对应不到（含多个副本同名）时返回空串。调用方须持有 r.mu。
+func (r *endpointRouting) leaderAddrLocked() string {
+	addrs := r.endpoints()
+	if r.cfg.LeaderAddress != nil {
+		if addr := r.cfg.LeaderAddress(r.leader); slices.Contains(addrs, addr) {
+			return addr
+		}
+		return ""
+	}
+	if slices.Contains(addrs, r.leader) {
+		return r.leader
+	}
+	match := ""
+	for _, a := range addrs {
+		host, _, err := net.SplitHostPort(a)
+		if err != nil {
+			continue
+		}
+		if first, _, _ := strings.Cut(host, "."); host != r.leader && first != r.leader {
+			continue
+		}
+		if match != "" {
+			return ""
+		}
+		match = a
+	}
+	return match
+}
+
+// close 关闭 leader 连接；随主连接一起调用。
+func (r *endpointRouting) close() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	if r.leaderConn != nil {
+		_ = r.leaderConn.Close()
+		r.leaderConn = nil
+	}
+}
+
+// adminWriteMethods 发往 leader 的管理类写操作；未列出的方法（读操作、SubmitTask / SubmitTasks、新增方法）一律轮询。
+var adminWriteMethods = map[string]bool{
+	pb.SchedulerService_CreateJob_FullMethodName:            true,
+	pb.SchedulerService_UpdateJob_FullMethodName:            true,
+	pb.SchedulerService_DeleteJob_FullMethodName:            true,
+	pb.SchedulerService_PauseJob_FullMethodName:             true,
+	pb.SchedulerService_ResumeJob_FullMethodName:            true,
+	pb.SchedulerService_TriggerJob_FullMethodName:           true,
+	pb.SchedulerService_CancelRun_FullMethodName:            true,
+	pb.SchedulerService_RetryRun_FullMethodName:             true,
+	pb.SchedulerService_KickWorker_FullMethodName:           true,
+	pb.SchedulerService_ApprovePendingChange_FullMethodName: true,
+	pb.SchedulerService_RejectPendingChange_FullMethodName:  true,
+
+	pb.AppService_CreateApp_FullMethodName:      true,
+	pb.AppService_UpdateApp_FullMethodName:      true,
+	pb.AppService_ResetAppSecret_FullMethodName: true,
+	pb.AppService_DisableApp_FullMethodName:     true,
+	pb.AppService_EnableApp_FullMethodName:      true,
+	pb.AppService_DeleteApp_FullMethodName:      true,
+
+	pb.AlertService_CreateAlertRule_FullMethodName:    true,
+	pb.AlertService_UpdateAlertRule_FullMethodName:    true,
+	pb.AlertService_DeleteAlertRule_FullMethodName:    true,
+	pb.AlertService_CreateAlertChannel_FullMethodName: true,
+	pb.AlertService_UpdateAlertChannel_FullMethodName: true,
+	pb.AlertService_DeleteAlertChannel_FullMethodName: true,
+	pb.AlertService_TestAlertChannel_FullMethodName:   true,
+	pb.AlertService_BindJobAlert_FullMethodName:       true,
+	pb.AlertService_UnbindJobAlert_FullMethodName:     true,
+	pb.AlertService_ResolveAlert_FullMethodName:       true,
+	pb.AlertService_SilenceAlert_FullMethodName:       true,
+}
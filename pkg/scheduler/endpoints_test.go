@@ -0,0 +1,345 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// startReplicas 启动 n 个凭据相同的 fake scheduler，模拟多副本部署。
+func startReplicas(t *testing.T, n int) []*schedulertest.Server {
+	t.Helper()
+	srvs := make([]*schedulertest.Server, n)
+	for i := range srvs {
+		srvs[i] = schedulertest.NewServer(schedulertest.Options{})
+		t.Cleanup(srvs[i].Close)
+	}
+	return srvs
+}
+
+// startMultiClient 以多副本模式启动 Client，等待 worker 在任一副本注册。
+func startMultiClient(t *testing.T, srvs []*schedulertest.Server, mutate func(*Config)) *Client {
+	t.Helper()
+	cfg := Config{
+		AppName:             srvs[0].AppName(),
+		AppKey:              srvs[0].AppKey(),
+		AppSecret:           srvs[0].AppSecret(),
+		ReconnectMinBackoff: 20 * time.Millisecond,
+		ReconnectMaxBackoff: 100 * time.Millisecond,
+	}
+	for _, s := range srvs {
+		cfg.Endpoints = append(cfg.Endpoints, s.Addr())
+	}
+	if mutate != nil {
+		mutate(&cfg)
+	}
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	c.RegisterHandler("echo", func(_ context.Context, job *Job) (string, error) { return string(job.Payload), nil })
+	ctx := waitCtx(t)
+	if err := c.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = c.Stop(stopCtx)
+	})
+	waitWorkerOn(t, srvs)
+	return c
+}
+
+// waitWorkerOn 等待 worker 在 srvs 中任一副本注册，返回该副本下标。
+func waitWorkerOn(t *testing.T, srvs []*schedulertest.Server) int {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for i, s := range srvs {
+			if len(s.Registers()) > 0 {
+				return i
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("worker not registered on any replica")
+	return -1
+}
+
+func TestEndpoints_RoundRobinAndStreamFailover(t *testing.T) {
+	srvs := startReplicas(t, 3)
+	c := startMultiClient(t, srvs, nil)
+	ctx := waitCtx(t)
+
+	for i := range 9 {
+		if _, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", BizKey: fmt.Sprint("rr-", i)}); err != nil {
+			t.Fatalf("SubmitTask: %v", err)
+		}
+	}
+	for i, s := range srvs {
+		if n := len(s.Runs()); n == 0 {
+			t.Fatalf("replica %d received no SubmitTask, want round-robin across replicas", i)
+		}
+	}
+
+	// worker 所在副本宕机：stream 重连到其他副本，unary 调用继续可用
+	down := waitWorkerOn(t, srvs)
+	srvs[down].Close()
+	rest := slices.Delete(slices.Clone(srvs), down, down+1)
+	waitWorkerOn(t, rest)
+	for i := range 4 {
+		if _, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", BizKey: fmt.Sprint("after-", i)}); err != nil {
+			t.Fatalf("SubmitTask after failover: %v", err)
+		}
+	}
+}
+
+func TestEndpoints_HealthCheckSkipsNotServing(t *testing.T) {
+	srvs := startReplicas(t, 2)
+	c := startMultiClient(t, srvs, nil)
+	ctx := waitCtx(t)
+
+	srvs[0].SetServing(false)
+	time.Sleep(200 * time.Millisecond)
+	before := len(srvs[0].Runs())
+	for i := range 6 {
+		if _, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", BizKey: fmt.Sprint("hc-", i)}); err != nil {
+			t.Fatalf("SubmitTask: %v", err)
+		}
+	}
+	if n := len(srvs[0].Runs()); n != before {
+		t.Fatalf("NOT_SERVING replica received %d submits", n-before)
+	}
+	if n := len(srvs[1].Runs()); n < 6 {
+		t.Fatalf("healthy replica runs=%d, want >= 6", n)
+	}
+}
+
+func TestEndpoints_LeaderRoutesAdminWrites(t *testing.T) {
+	srvs := startReplicas(t, 2)
+	leader := srvs[1]
+	for _, s := range srvs {
+		s.SetLeader(leader.Addr())
+	}
+	c := startMultiClient(t, srvs, nil)
+	admin, err := c.Admin()
+	if err != nil {
+		t.Fatalf("Admin: %v", err)
+	}
+	ctx := waitCtx(t)
+	for i := range 4 {
+		if _, err := admin.CreateJob(ctx, &pb.Job{JobName: fmt.Sprint("job-", i)}); err != nil {
+			t.Fatalf("CreateJob: %v", err)
+		}
+	}
+	if n := len(leader.Jobs()); n != 4 {
+		t.Fatalf("leader jobs=%d, want 4", n)
+	}
+	if n := len(srvs[0].Jobs()); n != 0 {
+		t.Fatalf("follower received %d admin writes", n)
+	}
+
+	// leader 宕机：写操作回落到其余副本
+	leader.Close()
+	if _, err := admin.CreateJob(ctx, &pb.Job{JobName: "job-after"}); err != nil {
+		t.Fatalf("CreateJob after leader down: %v", err)
+	}
+	if n := len(srvs[0].Jobs()); n != 1 {
+		t.Fatalf("fallback replica jobs=%d, want 1", n)
+	}
+}
+
+func TestEndpoints_LeaderGivenAsNodeID(t *testing.T) {
+	srvs := startReplicas(t, 2)
+	for _, s := range srvs {
+		s.SetLeader("scheduler-1")
+	}
+	nodes := map[string]string{"scheduler-0": srvs[0].Addr(), "scheduler-1": srvs[1].Addr()}
+	c := startMultiClient(t, srvs, func(cfg *Config) {
+		cfg.LeaderAddress = func(leader string) string { return nodes[leader] }
+	})
+	admin, err := c.Admin()
+	if err != nil {
+		t.Fatalf("Admin: %v", err)
+	}
+	ctx := waitCtx(t)
+	for i := range 4 {
+		if _, err := admin.CreateJob(ctx, &pb.Job{JobName: fmt.Sprint("job-", i)}); err != nil {
+			t.Fatalf("CreateJob: %v", err)
+		}
+	}
+	if n0, n1 := len(srvs[0].Jobs()), len(srvs[1].Jobs()); n0 != 0 || n1 != 4 {
+		t.Fatalf("jobs per replica=%d/%d, want 0/4", n0, n1)
+	}
+}
+
+func TestEndpoints_StandaloneAdminDiscoversLeader(t *testing.T) {
+	srvs := startReplicas(t, 2)
+	for _, s := range srvs {
+		s.SetLeader(srvs[1].Addr())
+	}
+	cfg := Config{AppName: srvs[0].AppName(), AppKey: srvs[0].AppKey(), AppSecret: srvs[0].AppSecret()}
+	for _, s := range srvs {
+		cfg.Endpoints = append(cfg.Endpoints, s.Addr())
+	}
+	ctx := waitCtx(t)
+	admin, err := NewAdminClient(ctx, cfg)
+	if err != nil {
+		t.Fatalf("NewAdminClient: %v", err)
+	}
+	defer admin.Close()
+
+	// 没有 worker stream：首个响应头带回 leader，之后的写操作发往 leader
+	if _, _, err := admin.ListJobs(ctx, JobFilter{}, 1); err != nil {
+		t.Fatalf("ListJobs: %v", err)
+	}
+	for i := range 4 {
+		if _, err := admin.CreateJob(ctx, &pb.Job{JobName: fmt.Sprint("job-", i)}); err != nil {
+			t.Fatalf("CreateJob: %v", err)
+		}
+	}
+	if n0, n1 := len(srvs[0].Jobs()), len(srvs[1].Jobs()); n0 != 0 || n1 != 4 {
+		t.Fatalf("jobs per replica=%d/%d, want 0/4", n0, n1)
+	}
+
+	// leader 切换后跟随
+	for _, s := range srvs {
+		s.SetLeader(srvs[0].Addr())
+	}
+	if _, _, err := admin.ListJobs(ctx, JobFilter{}, 1); err != nil {
+		t.Fatalf("ListJobs: %v", err)
+	}
+	if _, err := admin.CreateJob(ctx, &pb.Job{JobName: "job-after"}); err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	if n := len(srvs[0].Jobs()); n != 1 {
+		t.Fatalf("new leader jobs=%d, want 1", n)
+	}
+}
+
+func TestEndpointRouting_LeaderAddr(t *testing.T) {
+	addrs := []string{"scheduler-0.scheduler-headless:9090", "scheduler-1.scheduler-headless:9090", "10.0.0.7:9090", "10.0.0.7:9091"}
+	r := &endpointRouting{cfg: &Config{}}
+	r.addrs.Store(&addrs)
+	for leader, want := range map[string]string{
+		"scheduler-1.scheduler-headless:9090": "scheduler-1.scheduler-headless:9090",
+		"scheduler-1":                         "scheduler-1.scheduler-headless:9090",
+		"scheduler-1.scheduler-headless":      "scheduler-1.scheduler-headless:9090",
+		"scheduler-2":                         "",
+		"10.0.0.7":                            "", // 同一主机多个副本，无法唯一对应
+		"10.0.0.7:9091":                       "10.0.0.7:9091",
+	} {
+		r.leader = leader
+		if got := r.leaderAddrLocked(); got != want {
+			t.Errorf("leader %q -> %q, want %q", leader, got, want)
+		}
+	}
+
+	r.cfg.LeaderAddress = func(string) string { return "elsewhere:9090" }
+	if got := r.leaderAddrLocked(); got != "" {
+		t.Fatalf("LeaderAddress outside replica list -> %q, want empty", got)
+	}
+}
+
+func TestEndpoints_ResolverRefresh(t *testing.T) {
+	srvs := startReplicas(t, 2)
+	var current atomic.Pointer[[]string]
+	current.Store(&[]string{srvs[0].Addr()})
+	c := startMultiClient(t, srvs, func(cfg *Config) {
+		cfg.Endpoints = nil
+		cfg.EndpointRefreshInterval = 20 * time.Millisecond
+		cfg.EndpointResolver = EndpointResolverFunc(func(context.Context) ([]string, error) {
+			return *current.Load(), nil
+		})
+	})
+	ctx := waitCtx(t)
+
+	current.Store(&[]string{srvs[1].Addr()})
+	deadline := time.Now().Add(3 * time.Second)
+	for i := 0; len(srvs[1].Runs()) == 0; i++ {
+		if time.Now().After(deadline) {
+			t.Fatal("resolver update not applied")
+		}
+		if _, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", BizKey: fmt.Sprint("wait-", i)}); err != nil {
+			t.Fatalf("SubmitTask: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	before := len(srvs[0].Runs())
+	for i := range 4 {
+		if _, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", BizKey: fmt.Sprint("new-", i)}); err != nil {
+			t.Fatalf("SubmitTask: %v", err)
+		}
+	}
+	if n := len(srvs[0].Runs()); n != before {
+		t.Fatalf("removed replica still received %d submits", n-before)
+	}
+}
+
+func TestEndpoints_ConfigAndHelpers(t *testing.T) {
+	cfg := Config{Endpoints: []string{"a:1", "b:1"}, AppName: "a", AppKey: "k", AppSecret: "s"}
+	cfg.applyDefaults()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Endpoints without Endpoint should be valid: %v", err)
+	}
+	if got := cfg.endpointDesc(); got != "a:1,b:1" {
+		t.Fatalf("endpointDesc=%q", got)
+	}
+	cfg.Endpoints = []string{"a:1", ""}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("empty endpoint must be rejected")
+	}
+
+	for method, want := range map[string]bool{
+		"/scheduler.v1.SchedulerService/CreateJob":    true,
+		"/scheduler.v1.SchedulerService/PauseJob":     true,
+		"/scheduler.v1.SchedulerService/CancelRun":    true,
+		"/scheduler.v1.AlertService/SilenceAlert":     true,
+		"/scheduler.v1.SchedulerService/GetRun":       false,
+		"/scheduler.v1.SchedulerService/ListJobs":     false,
+		"/scheduler.v1.SchedulerService/GetDashboard": false,
+		"/scheduler.v1.SchedulerService/SubmitTask":   false,
+		"/scheduler.v1.SchedulerService/SubmitTasks":  false,
+		"/scheduler.v1.SchedulerService/Unknown":      false,
+		"/scheduler.v1.WorkerService/Connect":         false,
+	} {
+		if got := adminWriteMethods[method]; got != want {
+			t.Errorf("adminWriteMethods[%s]=%v want %v", method, got, want)
+		}
+	}
+
+	// 只有只读方法自动重试；写操作与 SubmitTask / SubmitTasks 不得出现在 retryPolicy 中
+	var sc struct {
+		MethodConfig []struct {
+			Name []struct{ Service, Method string }
+		}
+	}
+	if err := json.Unmarshal([]byte(endpointServiceConfig), &sc); err != nil {
+		t.Fatalf("service config: %v", err)
+	}
+	for _, mc := range sc.MethodConfig {
+		for _, n := range mc.Name {
+			method := "/" + n.Service + "/" + n.Method
+			if n.Method == "" || adminWriteMethods[method] || strings.HasPrefix(n.Method, "Submit") {
+				t.Errorf("retryPolicy covers non-read method %s", method)
+			}
+		}
+	}
+
+	addrs, err := DNSEndpoints("localhost:9090").Resolve(context.Background())
+	if err != nil {
+		t.Fatalf("DNSEndpoints: %v", err)
+	}
+	if !slices.Contains(addrs, "127.0.0.1:9090") && !slices.Contains(addrs, "[::1]:9090") {
+		t.Fatalf("DNSEndpoints(localhost)=%v", addrs)
+	}
+}
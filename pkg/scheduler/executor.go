@@ -13,16 +13,19 @@ import (
 
 // 私有：jobCancels 是 Client 的一部分，但放在 executor.go 集中讲清楚生命周期。
 //
-// 设计：每条 Dispatch 启动一个独立 goroutine 执行 handler；
-//   - acquire semaphore 失败 → 立即 Ack(accepted=false, reason="inflight full")
-//   - acquire 成功 → Ack(accepted=true) → 跑 handler（带超时） → 上报 JobResult → release semaphore
-//   - Cancel 通过 jobCancels[runID] 找到对应 ctx.cancel
+// 设计：每条 Dispatch 经 runQueue 准入后在独立 goroutine 执行 handler；
+//   - Drain 之后 → 立即 Ack(accepted=false, reason="worker draining")
+//   - 并发已满且该 job 未开启队列 / 队列已满 → 立即 Ack(accepted=false, reason="inflight full" / "queue full")
+//   - 占到执行槽位 → Ack(accepted=true) → 跑 handler（带超时） → JobResult 入 resultOutbox → 释放槽位并启动排队任务
+//   - 进入等待队列 → Ack(accepted=true)，有 run 结束时按优先级出队执行
+//   - 同一 run_id 仍在执行 / 排队 → 立即 Ack(accepted=false, reason="run already inflight")
+//   - Cancel 通过 jobCancels[runID] 找到对应 ctx.cancel；排队中的 run 同时移出队列，排队超时同样立即出队
 
 // jobCancels 在 Client 上下文存活；这里给个 init 辅助，由 Client 首次使用时懒初始化。
 // 直接在 Client 结构体定义会让 client.go 变长，按 SRP 拆到这里。
 func (c *Client) initCancelsOnce() {
 	c.cancelsOnce.Do(func() {
-		c.cancels = make(map[string]context.CancelFunc)
+		c.cancels = make(map[string]*dispatchTask)
 	})
 }
 
@@ -30,115 +33,202 @@ func (c *Client) initCancelsOnce() {
 //
 // 关键约束：
 //   - 必须立刻 Ack（accepted=true / false），否则 server 端 sched_run 卡在 dispatched 状态
-//   - acquire 失败 → Ack(accepted=false, reason)，不算业务失败，server 端不会 retry
-//   - acquire 成功后失败：通过 JobResult 上报 RUN_STATUS_FAILED，server 端按 retry_max 评估
+//   - 准入失败 → Ack(accepted=false, reason)，不算业务失败，server 端不会 retry
+//   - 准入成功（含排队）后失败：通过 JobResult 上报 RUN_STATUS_FAILED，server 端按 retry_max 评估
 func (c *Client) onDispatch(parent context.Context, d *pb.Dispatch, sendCh chan<- *pb.WorkerMessage) {
 	if d == nil || d.RunId == "" {
 		logger.Warnf("[scheduler-sdk] dispatch with empty run_id, drop")
 		return
 	}
 
+	if c.draining.Load() {
+		// 服务端收到 draining 心跳前仍可能派发；拒收后由服务端改派
+		c.metrics.dispatch(d.JobName, dispatchRejected)
+		c.sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
+			Ack: &pb.JobAck{RunId: d.RunId, Accepted: false, Reason: "worker draining"},
+		}})
+		return
+	}
+
 	handler := c.lookupHandler(d.JobName)
 	if handler == nil {
 		// 未注册 → 直接 Ack(false)，避免服务端 timeout 等待
-		sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
+		c.metrics.dispatch(d.JobName, dispatchRejected)
+		c.sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
 			Ack: &pb.JobAck{RunId: d.RunId, Accepted: false, Reason: "handler not registered: " + d.JobName},
 		}})
 		logger.Warnf("[scheduler-sdk] dispatch unknown job=%s run_id=%s", d.JobName, d.RunId)
+		c.recordError(errSourceDispatch, d.RunId, d.JobName, "handler not registered")
 		return
 	}
 
-	// 尝试 acquire（非阻塞）；满了直接 Ack(false)
-	select {
-	case c.concurrencySem <- struct{}{}:
-	default:
-		sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
-			Ack: &pb.JobAck{RunId: d.RunId, Accepted: false, Reason: "inflight full"},
-		}})
-		logger.Warnf("[scheduler-sdk] reject run_id=%s reason=inflight full", d.RunId)
-		return
+	// 派生 handler ctx：超时优先用 dispatch.TimeoutSec，否则不超时（由业务自控）；排队时间同样计入超时，
+	// ReportProgress 续期租约。
+	// 基于 rootCtx 而非 session ctx：连接抖动不应取消执行中的任务，只有 Stop / 服务端 Cancel 才会；
+	// 结果经 resultOutbox 在重连后补报
+	base := c.rootCtx
+	if base == nil {
+		base = parent
 	}
-
-	// 立刻 Ack(accepted=true)
-	sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
-		Ack: &pb.JobAck{RunId: d.RunId, Accepted: true},
-	}})
-
-	// 派生 handler ctx：超时优先用 dispatch.TimeoutSec，否则不超时（由业务自控）
-	var handlerCtx context.Context
-	var handlerCancel context.CancelFunc
+	t := &dispatchTask{d: d, handler: handler, acceptedAt: time.Now()}
 	if d.TimeoutSec > 0 {
-		handlerCtx, handlerCancel = context.WithTimeout(parent, time.Duration(d.TimeoutSec)*time.Second)
+		t.lease, t.cancel = withLease(base, time.Duration(d.TimeoutSec)*time.Second)
+		t.ctx = t.lease
 	} else {
-		handlerCtx, handlerCancel = context.WithCancel(parent)
+		t.ctx, t.cancel = context.WithCancel(base)
 	}
 
-	// 注册取消句柄，供 onCancel 找到
+	// 注册取消句柄，供 onCancel 找到；排队中的 run 同样可被取消。
+	// 同一 run_id 仍在执行 / 排队时拒收重复派发，否则取消句柄被覆盖，Cancel 找不到仍在执行的那次
 	c.initCancelsOnce()
 	c.cancelsMu.Lock()
-	c.cancels[d.RunId] = handlerCancel
+	if _, dup := c.cancels[d.RunId]; dup {
+		c.cancelsMu.Unlock()
+		t.cancel()
+		c.metrics.dispatch(d.JobName, dispatchRejected)
+		c.sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
+			Ack: &pb.JobAck{RunId: d.RunId, Accepted: false, Reason: "run already inflight"},
+		}})
+		logger.Warnf("[scheduler-sdk] duplicate dispatch run_id=%s job=%s while inflight, reject", d.RunId, d.JobName)
+		c.recordError(errSourceDispatch, d.RunId, d.JobName, "run already inflight")
+		return
+	}
+	c.cancels[d.RunId] = t
 	c.cancelsMu.Unlock()
-
 	c.inflightWG.Add(1)
-	go func() {
-		defer c.inflightWG.Done()
-		defer func() {
-			handlerCancel()
-			c.cancelsMu.Lock()
-			delete(c.cancels, d.RunId)
-			c.cancelsMu.Unlock()
-			<-c.concurrencySem
-		}()
-
-		startedAt := time.Now()
-		job := &Job{
-			RunID:        d.RunId,
-			JobName:      d.JobName,
-			BizKey:       d.BizKey,
-			Payload:      d.Payload,
-			TriggerType:  d.TriggerType,
-			RetryCount:   d.RetryCount,
-			RetryMax:     d.RetryMax,
-			ShardIndex:   d.ShardIndex,
-			ShardTotal:   d.ShardTotal,
-			TimeoutSec:   d.TimeoutSec,
-			TraceID:      d.TraceId,
-			SpanID:       d.SpanId,
-			DispatchedAt: d.DispatchedAt,
+
+	admitted := c.runQueue.admit(t)
+	if admitted == admitRejected {
+		c.releaseTask(t)
+		reason := "inflight full"
+		if c.queueSizeOf(d.JobName) > 0 {
+			reason = "queue full"
 		}
+		c.metrics.dispatch(d.JobName, dispatchRejected)
+		c.sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
+			Ack: &pb.JobAck{RunId: d.RunId, Accepted: false, Reason: reason},
+		}})
+		logger.Warnf("[scheduler-sdk] reject run_id=%s job=%s reason=%s", d.RunId, d.JobName, reason)
+		c.recordError(errSourceDispatch, d.RunId, d.JobName, reason)
+		return
+	}
+
+	// 立刻 Ack(accepted=true)；排队中的 run 由 runQueue.done 出队后执行
+	c.sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Ack{
+		Ack: &pb.JobAck{RunId: d.RunId, Accepted: true},
+	}})
+	if admitted == admitRun {
+		c.metrics.dispatch(d.JobName, dispatchAccepted)
+		go c.execute(t, true)
+	} else {
+		c.metrics.dispatch(d.JobName, dispatchQueued)
+		// 排队期间超时 / 被取消：立即出队上报，不再占用队列容量等到轮到它
+		context.AfterFunc(t.ctx, func() { c.dequeueExpired(t) })
+	}
+}
 
-		output, err := runHandlerSafe(handlerCtx, handler, job)
-		endedAt := time.Now()
-
-		status := pb.RunStatus_RUN_STATUS_SUCCESS
-		errStr := ""
-		if err != nil {
-			// 区分 timeout / canceled / 业务失败
-			switch {
-			case errors.Is(err, context.DeadlineExceeded):
-				status = pb.RunStatus_RUN_STATUS_TIMEOUT
-			case errors.Is(err, context.Canceled):
-				// server 主动 Cancel 或 Stop()
-				status = pb.RunStatus_RUN_STATUS_CANCELED
-			default:
-				status = pb.RunStatus_RUN_STATUS_FAILED
+// execute 执行 t 并把结果放入 resultOutbox。holdsSlot 表示 t 占用了 runQueue 的执行槽位，
+// 结束时释放并启动因此可执行的排队任务；从队列中直接移除（取消）的任务为 false。
+func (c *Client) execute(t *dispatchTask, holdsSlot bool) {
+	d := t.d
+	defer func() {
+		c.releaseTask(t)
+		if holdsSlot {
+			for _, next := range c.runQueue.done(d.JobName) {
+				go c.execute(next, true)
 			}
-			errStr = err.Error()
 		}
-
-		duration := endedAt.Sub(startedAt)
-		sendOrDrop(sendCh, parent, &pb.WorkerMessage{Payload: &pb.WorkerMessage_Result{
-			Result: &pb.JobResult{
-				RunId:      d.RunId,
-				Status:     status,
-				Output:     output,
-				Error:      errStr,
-				DurationMs: int32(duration.Milliseconds()),
-				StartedAt:  startedAt.UnixMilli(),
-				EndedAt:    endedAt.UnixMilli(),
-			},
-		}})
 	}()
+
+	startedAt := time.Now()
+	t.startedAt.Store(startedAt.UnixNano())
+	output, err := c.runTask(t)
+	endedAt := time.Now()
+
+	status := runStatusOf(err)
+	errStr := ""
+	if err != nil {
+		errStr = err.Error()
+		c.recordError(errSourceHandler, d.RunId, d.JobName, errStr)
+	}
+
+	duration := endedAt.Sub(startedAt)
+	c.metrics.handled(d.JobName, outcomeOf(status), duration)
+	// 结果不直接写 sendCh：session 可能已在执行期间结束，交给 outbox 由当前（或下一个）session 送达
+	res := &pb.JobResult{
+		RunId:      d.RunId,
+		Status:     status,
+		Output:     output,
+		Error:      errStr,
+		DurationMs: int32(duration.Milliseconds()),
+		StartedAt:  startedAt.UnixMilli(),
+		EndedAt:    endedAt.UnixMilli(),
+	}
+	// RetryAfter / Permanent / WithOutput → suggest_retry_after_sec / non_retriable / 部分 output
+	applyHandlerError(res, err)
+	// 超过阈值的 output 转存到对象存储；失败时仍上报原文（可能被服务端截断）
+	if out, oerr := c.offload.offload("output", []byte(res.Output)); oerr != nil {
+		logger.Warnf("[scheduler-sdk] run_id=%s %v, report output inline", d.RunId, oerr)
+	} else {
+		res.Output = string(out)
+	}
+	// run 到达终态后服务端不会再派发：结果确认送达后删除其转存的 payload 对象（送达前删除会让重新派发的 run 取不到 payload）
+	var payload *OffloadRef
+	if ref, ok := ParseOffloadRef(d.Payload); ok && c.cfg.PayloadOffloadDeleteOnComplete && resultFinal(d, res) {
+		payload = ref
+	}
+	c.resultOutbox.put(res, payload)
+}
+
+// runTask 以 run span 包裹执行 handler；ctx 已结束的任务不再执行。
+func (c *Client) runTask(t *dispatchTask) (string, error) {
+	if err := t.ctx.Err(); err != nil {
+		return "", fmt.Errorf("scheduler: run not started while queued: %w", err)
+	}
+	d := t.d
+	job := &Job{
+		RunID:        d.RunId,
+		JobName:      d.JobName,
+		BizKey:       d.BizKey,
+		Payload:      d.Payload,
+		TriggerType:  d.TriggerType,
+		RetryCount:   d.RetryCount,
+		RetryMax:     d.RetryMax,
+		ShardIndex:   d.ShardIndex,
+		ShardTotal:   d.ShardTotal,
+		TimeoutSec:   d.TimeoutSec,
+		TraceID:      d.TraceId,
+		SpanID:       d.SpanId,
+		DispatchedAt: d.DispatchedAt,
+		Checkpoint:   d.Checkpoint,
+	}
+	ref, offloaded := ParseOffloadRef(d.Payload)
+	if offloaded {
+		job.lazy = &lazyPayload{ref: ref, o: c.offload}
+	}
+	ctx := context.WithValue(t.ctx, progressCtxKey{}, &runProgress{runID: d.RunId, box: c.progressBox, lease: t.lease})
+	spanCtx, span := startRunSpan(ctx, d)
+	output, err := runHandlerSafe(spanCtx, t.handler, job)
+	endRunSpan(span, runStatusOf(err), err)
+	return output, err
+}
+
+// releaseTask 注销取消句柄并结束 inflight 计数。
+func (c *Client) releaseTask(t *dispatchTask) {
+	t.cancel()
+	c.cancelsMu.Lock()
+	if c.cancels[t.d.RunId] == t {
+		delete(c.cancels, t.d.RunId)
+	}
+	c.cancelsMu.Unlock()
+	c.inflightWG.Done()
+}
+
+// queueSizeOf 返回 job 注册时的 WithQueue 容量。
+func (c *Client) queueSizeOf(jobName string) int {
+	c.runQueue.mu.Lock()
+	defer c.runQueue.mu.Unlock()
+	return c.runQueue.laneLocked(jobName).queueSize
 }
 
 // runHandlerSafe 执行 handler 并 recover panic，把 panic 转成 error。
@@ -152,14 +242,40 @@ func runHandlerSafe(ctx context.Context, h HandlerFunc, job *Job) (output string
 	return h(ctx, job)
 }
 
-// sendOrDrop 把 msg 投到 sendCh；ctx 已取消则丢弃（log warn）。
+// resultFinal 本次结果上报后 run 是否结束：成功、取消，或失败 / 超时但不可重试、重试已耗尽（服务端转 DEAD）。
+func resultFinal(d *pb.Dispatch, res *pb.JobResult) bool {
+	switch res.Status {
+	case pb.RunStatus_RUN_STATUS_SUCCESS, pb.RunStatus_RUN_STATUS_CANCELED:
+		return true
+	}
+	return res.NonRetriable || d.RetryCount >= d.RetryMax
+}
+
+// runStatusOf 把 handler 返回的 err 映射为上报给服务端的 RunStatus；onDispatch 与内置 middleware 共用。
+// 不可重试（Permanent / ErrPayloadDecode）同样上报 FAILED，由 non_retriable 让服务端转 DEAD。
+func runStatusOf(err error) pb.RunStatus {
+	switch {
+	case err == nil:
+		return pb.RunStatus_RUN_STATUS_SUCCESS
+	case errors.Is(err, context.DeadlineExceeded):
+		return pb.RunStatus_RUN_STATUS_TIMEOUT
+	case errors.Is(err, context.Canceled):
+		// server 主动 Cancel 或 Stop()
+		return pb.RunStatus_RUN_STATUS_CANCELED
+	default:
+		return pb.RunStatus_RUN_STATUS_FAILED
+	}
+}
+
+// sendOrDrop 把 msg 投到 sendCh；ctx 已取消则丢弃（log warn，计入 scheduler_sdk_dropped_total{type="ack"}）。
 //
-// 用于 onDispatch 中的 Ack/Result：如果 session 在派发完成前结束，结果无法回传，
-// 服务端会通过 timeout 兜底（dispatched 状态下经过 sched_job.timeout_seconds 后落到 timeout）。
-func sendOrDrop(ch chan<- *pb.WorkerMessage, ctx context.Context, msg *pb.WorkerMessage) {
+// 仅用于 onDispatch 中的 Ack：Ack 只对当前 session 有意义，session 结束后服务端会重新派发；
+// JobResult 走 resultOutbox，不会被丢弃。
+func (c *Client) sendOrDrop(ch chan<- *pb.WorkerMessage, ctx context.Context, msg *pb.WorkerMessage) {
 	select {
 	case ch <- msg:
 	case <-ctx.Done():
+		c.droppedAcks.Add(1)
 		logger.Warnf("[scheduler-sdk] drop outbound message, session ended")
 	}
 }
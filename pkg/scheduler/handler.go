@@ -15,7 +15,8 @@ type Job struct {
 	JobName string
 	BizKey  string
 
-	// 业务负载，由 SubmitTask / TriggerJob 写入；长度上限由服务端 sched_app.payload_max_bytes 控制
+	// 业务负载，由 SubmitTask / TriggerJob 写入；长度上限由服务端 sched_app.payload_max_bytes 控制。
+	// 超过 Config.PayloadOffloadThreshold 转存到对象存储的 payload 此处为 OffloadRef 引用信封，用 LoadPayload 取回
 	Payload []byte
 
 	// 触发类型 / 当前重试次数 / 最大重试 / 分片信息
@@ -34,6 +35,12 @@ type Job struct {
 
 	// 派发时刻（unix 秒），用于业务方判断是否陈旧任务
 	DispatchedAt int64
+
+	// 上次执行经 ReportCheckpoint 保存的断点（重试时由服务端回传）；首次执行为空
+	Checkpoint []byte
+
+	// lazy 转存 payload 的延迟下载状态；未转存为 nil
+	lazy *lazyPayload
 }
 
 // HandlerFunc 是业务方实现的任务处理函数。
@@ -41,8 +48,59 @@ type Job struct {
 // 约定：
 //   - 返回 (output, nil)  → 上报 RUN_STATUS_SUCCESS，output 作为 JobResult.output
 //   - 返回 (_, err)       → 上报 RUN_STATUS_FAILED，err.Error() 作为 JobResult.error
+//   - err 经 RetryAfter / Permanent / WithOutput 包装 → 额外填充建议重试间隔 / non_retriable / 部分 output
 //   - ctx 超时             → SDK 检测到 ctx.Err()==DeadlineExceeded 时上报 RUN_STATUS_TIMEOUT
 //   - panic               → SDK recover 后上报 RUN_STATUS_FAILED，error 含 panic 值
 //
 // ctx 由 SDK 派生：含 Job.TimeoutSec 超时 + cancel 信号；handler 必须尊重 ctx.Done()。
+// 长任务可用 ReportProgress / ReportCheckpoint 上报进度并续期超时。
 type HandlerFunc func(ctx context.Context, job *Job) (output string, err error)
+
+// HandlerOption RegisterHandler / RegisterTypedHandler 的 per-job 选项。
+//
+// Middleware 本身实现了 HandlerOption，可与 WithMaxConcurrency / WithQueue 混合传入：
+//
+//	c.RegisterHandler("export", h, scheduler.LoggingMiddleware(), scheduler.WithMaxConcurrency(2), scheduler.WithQueue(20))
+type HandlerOption interface {
+	applyHandler(o *handlerOptions)
+}
+
+// handlerOptions 单个 job 的注册选项。
+type handlerOptions struct {
+	middlewares    []Middleware
+	maxConcurrency int // 0 表示仅受 Config.MaxConcurrency 约束
+	queueSize      int // 0 表示不排队，并发已满直接拒收
+}
+
+type handlerOptionFunc func(o *handlerOptions)
+
+func (f handlerOptionFunc) applyHandler(o *handlerOptions) { f(o) }
+
+// applyHandler 把 Middleware 作为 per-job middleware 追加。
+func (m Middleware) applyHandler(o *handlerOptions) {
+	if m == nil {
+		panic("scheduler: RegisterHandler with nil middleware")
+	}
+	o.middlewares = append(o.middlewares, m)
+}
+
+// WithMaxConcurrency 限制该 job 同时执行的 run 数，避免慢 job 占满 Config.MaxConcurrency 饿死其他 handler。
+// 0 表示不单独限制；n<0 直接 panic。
+func WithMaxConcurrency(n int) HandlerOption {
+	if n < 0 {
+		panic("scheduler: WithMaxConcurrency with negative limit")
+	}
+	return handlerOptionFunc(func(o *handlerOptions) { o.maxConcurrency = n })
+}
+
+// WithQueue 为该 job 开启容量为 size 的本地等待队列：job 或进程并发已满时 Dispatch 先 Ack 并排队，
+// 按 Dispatch.priority（HIGH 优先）、dispatched_at 先后出队；队列也满时才 Ack(accepted=false)。
+//
+// 排队时间计入 Dispatch.timeout_sec；排队期间超时或被取消的 run 立即出队（不再占用队列容量），不执行 handler，
+// 直接上报 TIMEOUT / CANCELED。
+func WithQueue(size int) HandlerOption {
+	if size < 0 {
+		panic("scheduler: WithQueue with negative size")
+	}
+	return handlerOptionFunc(func(o *handlerOptions) { o.queueSize = size })
+}
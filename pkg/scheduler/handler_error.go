@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// handlerError 携带上报语义的 handler 错误，由 RetryAfter / Permanent / WithOutput 构造。
+// 可多层嵌套（如 Permanent(WithOutput(err, out))），同一属性以最外层为准。
+type handlerError struct {
+	err error
+
+	retryAfter time.Duration
+	permanent  bool
+	output     *string
+}
+
+func (e *handlerError) Error() string { return e.err.Error() }
+func (e *handlerError) Unwrap() error { return e.err }
+
+// RetryAfter 标记 err 建议在 d 之后重试，映射为 JobResult.suggest_retry_after_sec（向上取整到秒），
+// 覆盖 job 配置的 retry_backoff；是否重试仍由服务端按 retry_max 决定。err 为 nil 时返回 nil。
+//
+//	if resp.StatusCode == http.StatusTooManyRequests {
+//	    return "", scheduler.RetryAfter(errRateLimited, 30*time.Second)
+//	}
+func RetryAfter(err error, d time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	return &handlerError{err: err, retryAfter: d}
+}
+
+// Permanent 标记 err 为永久失败（如参数非法、业务状态不可能成功），上报 FAILED 且 JobResult.non_retriable=true，
+// 服务端直接转 DEAD，不再消耗重试次数。err 为 nil 时返回 nil。
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &handlerError{err: err, permanent: true}
+}
+
+// WithOutput 在失败时仍上报部分结果（如已处理的条数、失败明细），写入 JobResult.output；
+// 普通 error 失败时 output 为空。err 为 nil 时返回 nil。
+func WithOutput(err error, partial string) error {
+	if err == nil {
+		return nil
+	}
+	return &handlerError{err: err, output: &partial}
+}
+
+// IsPermanent 判断 err 是否会以 non_retriable 上报：Permanent 标记或 typed handler 的 ErrPayloadDecode。
+func IsPermanent(err error) bool {
+	if errors.Is(err, ErrPayloadDecode) {
+		return true
+	}
+	permanent := false
+	walkHandlerErrors(err, func(he *handlerError) bool {
+		permanent = he.permanent
+		return !permanent
+	})
+	return permanent
+}
+
+// applyHandlerError 把 handler 返回的 err 映射到 JobResult 的 output / suggest_retry_after_sec / non_retriable。
+func applyHandlerError(res *pb.JobResult, err error) {
+	if err == nil {
+		return
+	}
+	var retryAfter time.Duration
+	var output *string
+	walkHandlerErrors(err, func(he *handlerError) bool {
+		if retryAfter == 0 && he.retryAfter > 0 {
+			retryAfter = he.retryAfter
+		}
+		if output == nil && he.output != nil {
+			output = he.output
+		}
+		return true
+	})
+	if retryAfter > 0 {
+		res.SuggestRetryAfterSec = int32((retryAfter + time.Second - 1) / time.Second)
+	}
+	if output != nil {
+		res.Output = *output
+	}
+	res.NonRetriable = IsPermanent(err)
+}
+
+// walkHandlerErrors 由外到内遍历 err 链（含 errors.Join）上的 handlerError；fn 返回 false 时停止。
+func walkHandlerErrors(err error, fn func(*handlerError) bool) bool {
+	for err != nil {
+		if he, ok := err.(*handlerError); ok && !fn(he) {
+			return false
+		}
+		switch x := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, e := range x.Unwrap() {
+				if !walkHandlerErrors(e, fn) {
+					return false
+				}
+			}
+			return true
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		default:
+			return true
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+func TestApplyHandlerError(t *testing.T) {
+	base := errors.New("boom")
+	tests := []struct {
+		name          string
+		err           error
+		wantRetry     int32
+		wantPermanent bool
+		wantOutput    string
+	}{
+		{name: "普通错误", err: base},
+		{name: "RetryAfter 向上取整", err: RetryAfter(base, 1500*time.Millisecond), wantRetry: 2},
+		{name: "Permanent", err: Permanent(base), wantPermanent: true},
+		{name: "WithOutput", err: WithOutput(base, `{"done":3}`), wantOutput: `{"done":3}`},
+		{name: "嵌套组合", err: Permanent(WithOutput(RetryAfter(base, time.Minute), "partial")), wantRetry: 60, wantPermanent: true, wantOutput: "partial"},
+		{name: "外层优先", err: WithOutput(WithOutput(base, "inner"), "outer"), wantOutput: "outer"},
+		{name: "fmt.Errorf 包装", err: fmt.Errorf("step 2: %w", Permanent(base)), wantPermanent: true},
+		{name: "errors.Join", err: errors.Join(base, RetryAfter(base, time.Second)), wantRetry: 1},
+		{name: "ErrPayloadDecode", err: fmt.Errorf("%w: bad json", ErrPayloadDecode), wantPermanent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &pb.JobResult{}
+			applyHandlerError(res, tt.err)
+			if res.SuggestRetryAfterSec != tt.wantRetry || res.NonRetriable != tt.wantPermanent || res.Output != tt.wantOutput {
+				t.Fatalf("got retry=%d permanent=%v output=%q", res.SuggestRetryAfterSec, res.NonRetriable, res.Output)
+			}
+			if !errors.Is(tt.err, base) && !errors.Is(tt.err, ErrPayloadDecode) {
+				t.Fatal("wrapped error must stay inspectable with errors.Is")
+			}
+		})
+	}
+
+	if RetryAfter(nil, time.Second) != nil || Permanent(nil) != nil || WithOutput(nil, "x") != nil {
+		t.Fatal("constructors must return nil for nil err")
+	}
+}
+
+func TestIntegration_StructuredHandlerError(t *testing.T) {
+	srv, _ := startFakeClient(t, schedulertest.Options{}, nil, map[string]HandlerFunc{
+		"import": func(_ context.Context, _ *Job) (string, error) {
+			return "", Permanent(WithOutput(errors.New("row 4: invalid sku"), `{"imported":3}`))
+		},
+	})
+	ctx := waitCtx(t)
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "import"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	res, err := srv.WaitResult(ctx, "r1")
+	if err != nil {
+		t.Fatalf("WaitResult: %v", err)
+	}
+	if res.Status != pb.RunStatus_RUN_STATUS_FAILED || !res.NonRetriable || res.Output != `{"imported":3}` || res.Error != "row 4: invalid sku" {
+		t.Fatalf("unexpected result: %v", res)
+	}
+	for _, run := range srv.Runs() {
+		if run.RunId == "r1" && run.Status != pb.RunStatus_RUN_STATUS_DEAD {
+			t.Fatalf("non-retriable run should be DEAD, got %s", run.Status)
+		}
+	}
+}
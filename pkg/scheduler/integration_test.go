@@ -0,0 +1,276 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// startFakeClient 启动 fake scheduler + 已注册 handler 的 Client，并等待 worker 注册完成。
+func startFakeClient(t *testing.T, opts schedulertest.Options, mutate func(*Config), handlers map[string]HandlerFunc) (*schedulertest.Server, *Client) {
+	t.Helper()
+	return startFakeClientWith(t, opts, mutate, func(c *Client) {
+		for name, h := range handlers {
+			c.RegisterHandler(name, h)
+		}
+	})
+}
+
+// startFakeClientWith 同 startFakeClient，由 register 在 Start 之前自行注册 handler（可带 HandlerOption）。
+func startFakeClientWith(t *testing.T, opts schedulertest.Options, mutate func(*Config), register func(*Client)) (*schedulertest.Server, *Client) {
+	t.Helper()
+	srv := schedulertest.NewServer(opts)
+	t.Cleanup(srv.Close)
+
+	cfg := Config{
+		Endpoint:            srv.Addr(),
+		AppName:             srv.AppName(),
+		AppKey:              srv.AppKey(),
+		AppSecret:           srv.AppSecret(),
+		ReconnectMinBackoff: 20 * time.Millisecond,
+		ReconnectMaxBackoff: 100 * time.Millisecond,
+	}
+	if mutate != nil {
+		mutate(&cfg)
+	}
+	c, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	register(c)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() {
+		stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = c.Stop(stopCtx)
+	})
+	if err := srv.WaitWorker(ctx); err != nil {
+		t.Fatalf("worker not registered: %v", err)
+	}
+	return srv, c
+}
+
+func waitCtx(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestIntegration_DispatchReportsResult(t *testing.T) {
+	srv, _ := startFakeClient(t, schedulertest.Options{}, nil, map[string]HandlerFunc{
+		"echo": func(_ context.Context, job *Job) (string, error) { return string(job.Payload), nil },
+	})
+	if regs := srv.Registers(); len(regs) != 1 || len(regs[0].HandlerJobs) != 1 || regs[0].HandlerJobs[0] != "echo" {
+		t.Fatalf("unexpected register: %v", regs)
+	}
+
+	ctx := waitCtx(t)
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "echo", Payload: []byte("hi"), TimeoutSec: 5}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	ack, err := srv.WaitAck(ctx, "r1")
+	if err != nil || !ack.Accepted {
+		t.Fatalf("ack=%v err=%v", ack, err)
+	}
+	res, err := srv.WaitResult(ctx, "r1")
+	if err != nil {
+		t.Fatalf("WaitResult: %v", err)
+	}
+	if res.Status != pb.RunStatus_RUN_STATUS_SUCCESS || res.Output != "hi" {
+		t.Fatalf("unexpected result: %v", res)
+	}
+
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r2", JobName: "missing"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	if ack, err := srv.WaitAck(ctx, "r2"); err != nil || ack.Accepted {
+		t.Fatalf("dispatch of unknown job must be rejected, ack=%v err=%v", ack, err)
+	}
+}
+
+func TestIntegration_ResultReplayedAfterReconnect(t *testing.T) {
+	release := make(chan struct{})
+	srv, _ := startFakeClient(t, schedulertest.Options{}, nil, map[string]HandlerFunc{
+		"slow": func(ctx context.Context, _ *Job) (string, error) {
+			select {
+			case <-release:
+				return "done", nil
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		},
+	})
+
+	ctx := waitCtx(t)
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "slow", TimeoutSec: 30}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	if _, err := srv.WaitAck(ctx, "r1"); err != nil {
+		t.Fatalf("WaitAck: %v", err)
+	}
+	// 断线期间 handler 完成：结果应留在 outbox，重连后补报，且 handler 不因断线被取消
+	srv.KillStream()
+	close(release)
+	if err := srv.WaitRegisters(ctx, 2); err != nil {
+		t.Fatalf("client did not reconnect: %v", err)
+	}
+	res, err := srv.WaitResult(ctx, "r1")
+	if err != nil {
+		t.Fatalf("WaitResult: %v", err)
+	}
+	if res.Status != pb.RunStatus_RUN_STATUS_SUCCESS || res.Output != "done" {
+		t.Fatalf("unexpected replayed result: %v", res)
+	}
+}
+
+func TestIntegration_EnqueueRetriesUntilSchedulerRecovers(t *testing.T) {
+	got := make(chan string, 1)
+	srv, c := startFakeClient(t, schedulertest.Options{AutoDispatch: true}, func(cfg *Config) {
+		cfg.LocalBufferEnabled = true
+		cfg.LocalBufferRetryInterval = 20 * time.Millisecond
+	}, map[string]HandlerFunc{
+		"order.sync": func(_ context.Context, job *Job) (string, error) {
+			got <- job.BizKey
+			return "", nil
+		},
+	})
+
+	ctx := waitCtx(t)
+	srv.FailSubmit(status.Error(codes.Unavailable, "scheduler down"))
+	queued, err := c.EnqueueTask(ctx, SubmitOptions{JobName: "order.sync", BizKey: "o-1"})
+	if err != nil || !queued {
+		t.Fatalf("EnqueueTask queued=%v err=%v", queued, err)
+	}
+	if c.BufferedCount() != 1 {
+		t.Fatalf("buffered got=%d", c.BufferedCount())
+	}
+
+	srv.FailSubmit(nil)
+	select {
+	case bizKey := <-got:
+		if bizKey != "o-1" {
+			t.Fatalf("biz_key got=%s", bizKey)
+		}
+	case <-ctx.Done():
+		t.Fatal("buffered task was not resubmitted and dispatched")
+	}
+	if srv.BatchSubmits() == 0 {
+		t.Fatal("buffer replay should use SubmitTasks")
+	}
+}
+
+func TestIntegration_DelayedSubmitDispatchedWhenDue(t *testing.T) {
+	started := make(chan time.Time, 1)
+	_, c := startFakeClient(t, schedulertest.Options{AutoDispatch: true}, nil, map[string]HandlerFunc{
+		"callback.retry": func(_ context.Context, _ *Job) (string, error) {
+			started <- time.Now()
+			return "", nil
+		},
+	})
+	ctx := waitCtx(t)
+
+	submitted := time.Now()
+	runID, _, err := c.SubmitTask(ctx, SubmitOptions{
+		JobName:  "callback.retry",
+		Delay:    400 * time.Millisecond,
+		Priority: pb.Priority_PRIORITY_HIGH,
+	})
+	if err != nil {
+		t.Fatalf("SubmitTask: %v", err)
+	}
+	run, err := c.GetRun(ctx, runID)
+	if err != nil {
+		t.Fatalf("GetRun: %v", err)
+	}
+	if run.Status != pb.RunStatus_RUN_STATUS_PENDING || run.NextRunAt < submitted.Unix() || run.Priority != pb.Priority_PRIORITY_HIGH {
+		t.Fatalf("delayed run=%v", run)
+	}
+	if _, err := c.WaitRun(ctx, runID); err != nil {
+		t.Fatalf("WaitRun: %v", err)
+	}
+	if at := <-started; at.Sub(submitted) < 400*time.Millisecond {
+		t.Fatalf("delayed run dispatched after %s, before its RunAt", at.Sub(submitted))
+	}
+
+	// 到期前取消的延迟 run 不再派发
+	runID, _, err = c.SubmitTask(ctx, SubmitOptions{JobName: "callback.retry", RunAt: time.Now().Add(200 * time.Millisecond)})
+	if err != nil {
+		t.Fatalf("SubmitTask: %v", err)
+	}
+	if err := c.CancelRun(ctx, runID, "no longer needed"); err != nil {
+		t.Fatalf("CancelRun: %v", err)
+	}
+	select {
+	case <-started:
+		t.Fatal("canceled delayed run was dispatched")
+	case <-time.After(400 * time.Millisecond):
+	}
+}
+
+func TestIntegration_SubmitEnsureJobAndCancel(t *testing.T) {
+	srv, c := startFakeClient(t, schedulertest.Options{AutoDispatch: true}, nil, map[string]HandlerFunc{
+		"block": func(ctx context.Context, _ *Job) (string, error) {
+			<-ctx.Done()
+			return "", ctx.Err()
+		},
+	})
+	ctx := waitCtx(t)
+
+	created, err := c.EnsureJob(ctx, &pb.Job{JobName: "block"})
+	if err != nil || !created {
+		t.Fatalf("first EnsureJob created=%v err=%v", created, err)
+	}
+	if created, err = c.EnsureJob(ctx, &pb.Job{JobName: "block"}); err != nil || created {
+		t.Fatalf("second EnsureJob created=%v err=%v", created, err)
+	}
+	// 非法 cron 在本地拒绝，不发起 CreateJob
+	bad := &pb.Job{JobName: "bad-cron", TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON, CronExpr: "0 0 25 * * *"}
+	if _, err := c.EnsureJob(ctx, bad); !errors.Is(err, ErrInvalidJobDef) || len(srv.Jobs()) != 1 {
+		t.Fatalf("EnsureJob invalid cron err=%v jobs=%d", err, len(srv.Jobs()))
+	}
+
+	runID, dedup, err := c.SubmitTask(ctx, SubmitOptions{JobName: "block", BizKey: "k", DedupeWindowSec: 60})
+	if err != nil || dedup {
+		t.Fatalf("SubmitTask runID=%s dedup=%v err=%v", runID, dedup, err)
+	}
+	again, dedup, err := c.SubmitTask(ctx, SubmitOptions{JobName: "block", BizKey: "k", DedupeWindowSec: 60})
+	if err != nil || !dedup || again != runID {
+		t.Fatalf("duplicate submit runID=%s dedup=%v err=%v", again, dedup, err)
+	}
+	if _, err := srv.WaitAck(ctx, runID); err != nil {
+		t.Fatalf("WaitAck: %v", err)
+	}
+
+	if err := c.CancelRun(ctx, runID, "user abort"); err != nil {
+		t.Fatalf("CancelRun: %v", err)
+	}
+	res, err := srv.WaitResult(ctx, runID)
+	if err != nil {
+		t.Fatalf("WaitResult: %v", err)
+	}
+	if res.Status != pb.RunStatus_RUN_STATUS_CANCELED {
+		t.Fatalf("canceled run reported %s", res.Status)
+	}
+	run, err := c.GetRun(ctx, runID)
+	if err != nil {
+		t.Fatalf("GetRun: %v", err)
+	}
+	if run.Status != pb.RunStatus_RUN_STATUS_CANCELED {
+		t.Fatalf("run status got=%s", run.Status)
+	}
+
+	if _, err := c.GetRun(ctx, "nope"); status.Code(err) != codes.NotFound {
+		t.Fatalf("GetRun unknown err=%v", err)
+	}
+}
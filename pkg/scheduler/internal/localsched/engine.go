@@ -0,0 +1,653 @@
+// Package localsched 是 scheduler SDK 本地模式（scheduler.NewLocal）的进程内调度引擎。
+//
+// Engine 实现 WorkerService.Connect 与 SchedulerService 的 run / job 管理 RPC，经 bufconn 提供给同进程的 Client，
+// SDK 的执行、进度、WatchRun、AdminClient 等逻辑因此与连接真实 scheduler 时一致。Engine 自身负责：
+//   - 按 cron_expr（含 timezone）/ fixed_rate_seconds / one_time_at 触发 job，按 misfire_policy 处理错过的触发
+//   - FAILED / TIMEOUT 的 run 按 retry_max / retry_backoff 重试，用尽后转 DEAD
+//   - 可选把 job 与 run 追加写入本地文件，重启后恢复（未结束的 run 重新排队）
+package localsched
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	"github.com/sidchai/compkg/pkg/scheduler/cron"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultMaxRuns 默认保留的已结束 run 条数
+	defaultMaxRuns = 10000
+	// defaultRetryBackoff job 未配置 retry_backoff 时的重试间隔
+	defaultRetryBackoff = 10 * time.Second
+	// rejectRetryDelay worker 拒收（Ack accepted=false）后重新派发的间隔
+	rejectRetryDelay = time.Second
+	// misfireThreshold 触发时间晚于计划超过该值视为错过（进程停止、暂停期间等），按 misfire_policy 处理
+	misfireThreshold = 5 * time.Second
+
+	bufconnSize = 1 << 20
+)
+
+// Misfire 策略（Job.misfire_policy）。
+const (
+	// MisfireSkip 跳过错过的触发，从当前时间起等待下一次（默认）
+	MisfireSkip = "skip"
+	// MisfireFireOnce 错过一次或多次都只立即补跑一次
+	MisfireFireOnce = "fire_once"
+)
+
+// Options 是 New 的参数。
+type Options struct {
+	// AppName 本地 job / run 的 app_name
+	AppName string
+	// HistoryFile 非空时 job 与 run 持久化到该文件，重启后恢复
+	HistoryFile string
+	// MaxRuns 保留的已结束 run 条数，超出淘汰最早结束的；默认 10000
+	MaxRuns int
+	// HeartbeatIntervalSec RegisterResponse.heartbeat_interval；默认 5
+	HeartbeatIntervalSec int32
+}
+
+// Engine 进程内 scheduler；所有方法并发安全。
+type Engine struct {
+	pb.UnimplementedWorkerServiceServer
+	pb.UnimplementedSchedulerServiceServer
+
+	opts Options
+	lis  *bufconn.Listener
+	srv  *grpc.Server
+	hist *history // nil 表示不持久化
+
+	mu         sync.Mutex
+	changed    chan struct{} // 任一 run 变化时 close 并替换，用于 WatchRun
+	jobs       map[string]*jobEntry
+	runs       map[string]*pb.Run
+	due        map[string]time.Time // 待派发 run（PENDING / 待重试）→ 计划派发时间
+	checkpoint map[string][]byte    // run_id → 最近一次非空 checkpoint
+	dedup      map[string]dedupEntry
+	finished   []string // 已结束 run，按结束先后，用于淘汰
+	seq        int64
+	jobSeq     int64
+	worker     *session
+
+	wake     chan struct{}
+	stop     chan struct{}
+	loopDone chan struct{}
+}
+
+// jobEntry job 配置及其自动触发计划。
+type jobEntry struct {
+	job  *pb.Job
+	cron *cron.Schedule
+	next time.Time // 下次自动触发时间；零值表示不自动触发
+}
+
+type dedupEntry struct {
+	runID     string
+	expiresAt time.Time
+}
+
+// New 创建并启动 Engine：加载历史文件（如配置）、启动调度循环与 bufconn 上的 gRPC 服务。
+func New(opts Options) (*Engine, error) {
+	if opts.MaxRuns <= 0 {
+		opts.MaxRuns = defaultMaxRuns
+	}
+	if opts.HeartbeatIntervalSec <= 0 {
+		opts.HeartbeatIntervalSec = 5
+	}
+	e := &Engine{
+		opts:       opts,
+		changed:    make(chan struct{}),
+		jobs:       make(map[string]*jobEntry),
+		runs:       make(map[string]*pb.Run),
+		due:        make(map[string]time.Time),
+		checkpoint: make(map[string][]byte),
+		dedup:      make(map[string]dedupEntry),
+		wake:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		loopDone:   make(chan struct{}),
+	}
+	if opts.HistoryFile != "" {
+		if err := e.restore(); err != nil {
+			return nil, err
+		}
+	}
+
+	e.lis = bufconn.Listen(bufconnSize)
+	e.srv = grpc.NewServer(grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		// SDK 每 30s 发送 keepalive ping
+		MinTime:             10 * time.Second,
+		PermitWithoutStream: true,
+	}))
+	pb.RegisterWorkerServiceServer(e.srv, e)
+	pb.RegisterSchedulerServiceServer(e.srv, e)
+	go func() { _ = e.srv.Serve(e.lis) }()
+	go e.loop()
+	return e, nil
+}
+
+// Dial 供 grpc.WithContextDialer 使用，返回到 Engine 的内存连接。
+func (e *Engine) Dial(ctx context.Context, _ string) (net.Conn, error) {
+	return e.lis.DialContext(ctx)
+}
+
+// Close 停止调度循环与 gRPC 服务；配置了历史文件时重写并关闭。
+func (e *Engine) Close() error {
+	select {
+	case <-e.stop:
+		return nil
+	default:
+	}
+	close(e.stop)
+	<-e.loopDone
+	e.srv.Stop()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.hist == nil {
+		return nil
+	}
+	if err := e.hist.compact(e.jobs, e.runs); err != nil {
+		logger.Warnf("[scheduler-sdk] local history: %v", err)
+	}
+	return e.hist.close()
+}
+
+// restore 从历史文件恢复 job / run：未结束的 run 重新排队，待重试 / 延迟的 run 按 next_run_at 派发。
+func (e *Engine) restore() error {
+	h, jobs, runs, err := openHistory(e.opts.HistoryFile)
+	if err != nil {
+		return err
+	}
+	e.hist = h
+	now := time.Now()
+	for _, job := range jobs {
+		je, err := newJobEntry(job)
+		if err != nil {
+			logger.Warnf("[scheduler-sdk] local job %s: %v", job.JobName, err)
+			je = &jobEntry{job: job}
+		}
+		if job.NextRunAt > 0 {
+			// 沿用持久化的计划时间，错过的触发交给 misfire_policy
+			je.next = time.Unix(job.NextRunAt, 0)
+		} else {
+			je.schedule(now)
+		}
+		e.jobs[job.JobName] = je
+		e.jobSeq = max(e.jobSeq, job.Id)
+	}
+	ended := make([]*pb.Run, 0, len(runs))
+	for id, run := range runs {
+		e.runs[id] = run
+		e.seq = max(e.seq, runSeq(id))
+		switch {
+		case runFinished(run):
+			ended = append(ended, run)
+		case run.Status == pb.RunStatus_RUN_STATUS_RUNNING && run.ShardTotal > 0 && run.ParentRunId == "":
+			// 分片父 run 由分片结果汇总
+		case run.NextRunAt > 0:
+			e.due[id] = time.Unix(run.NextRunAt, 0)
+		default:
+			run.Status = pb.RunStatus_RUN_STATUS_PENDING
+			e.due[id] = now
+		}
+	}
+	sort.Slice(ended, func(i, j int) bool { return ended[i].EndedAt < ended[j].EndedAt })
+	for _, run := range ended {
+		e.finished = append(e.finished, run.RunId)
+	}
+	e.trimLocked()
+	return e.hist.compact(e.jobs, e.runs)
+}
+
+func newJobEntry(job *pb.Job) (*jobEntry, error) {
+	je := &jobEntry{job: job}
+	if job.TriggerType != pb.TriggerType_TRIGGER_TYPE_CRON {
+		return je, nil
+	}
+	sched, err := cron.ParseInZone(job.CronExpr, job.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	je.cron = sched
+	return je, nil
+}
+
+// schedule 从 from 起计算下次自动触发时间。
+func (je *jobEntry) schedule(from time.Time) {
+	je.next = time.Time{}
+	switch je.job.TriggerType {
+	case pb.TriggerType_TRIGGER_TYPE_CRON:
+		if je.cron != nil {
+			je.next = je.cron.Next(from)
+		}
+	case pb.TriggerType_TRIGGER_TYPE_FIXED_RATE:
+		if je.job.FixedRateSeconds > 0 {
+			je.next = from.Add(time.Duration(je.job.FixedRateSeconds) * time.Second)
+		}
+	case pb.TriggerType_TRIGGER_TYPE_ONE_TIME:
+		if at := time.Unix(je.job.OneTimeAt, 0); je.job.OneTimeAt > 0 && at.After(from) {
+			je.next = at
+		}
+	}
+	je.job.NextRunAt = 0
+	if !je.next.IsZero() {
+		je.job.NextRunAt = je.next.Unix()
+	}
+}
+
+// advance 本次触发（计划时间 je.next）处理完毕后推进到下一次；错过的触发（late）从 now 起重新计算。
+func (je *jobEntry) advance(now time.Time, late bool) {
+	if je.job.TriggerType == pb.TriggerType_TRIGGER_TYPE_ONE_TIME {
+		// 一次性 job 只触发一次
+		je.next, je.job.NextRunAt = time.Time{}, 0
+		return
+	}
+	from := je.next
+	if late {
+		from = now
+	}
+	je.schedule(from)
+}
+
+func (je *jobEntry) active() bool {
+	return je.job.Status != "paused" && je.job.Status != "disabled"
+}
+
+// ===== 调度循环 =====
+
+// notifyLocked 唤醒 WatchRun 与调度循环；调用方须持有 e.mu。
+func (e *Engine) notifyLocked() {
+	close(e.changed)
+	e.changed = make(chan struct{})
+	select {
+	case e.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (e *Engine) loop() {
+	defer close(e.loopDone)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-e.stop:
+			return
+		case <-timer.C:
+		case <-e.wake:
+		}
+		next := e.tick(time.Now())
+		timer.Stop()
+		select {
+		case <-timer.C:
+		default:
+		}
+		if !next.IsZero() {
+			timer.Reset(max(time.Until(next), 0))
+		}
+	}
+}
+
+// tick 触发到期的 job、派发到期的 run，返回下一次需要处理的时间（零值表示无）。
+func (e *Engine) tick(now time.Time) time.Time {
+	type outbound struct {
+		sess *session
+		d    *pb.Dispatch
+	}
+	var sends []outbound
+	e.mu.Lock()
+	for _, je := range e.jobs {
+		if je.next.IsZero() || now.Before(je.next) || !je.active() {
+			continue
+		}
+		late := now.Sub(je.next) > misfireThreshold
+		if late && je.job.MisfirePolicy != MisfireFireOnce {
+			logger.Infof("[scheduler-sdk] local job %s misfired at %s, skip", je.job.JobName, je.next.Format(time.RFC3339))
+		} else {
+			e.fireLocked(je.job, je.job.TriggerType, "", nil, "")
+		}
+		je.advance(now, late)
+		e.putJobLocked(je.job)
+	}
+
+	ids := make([]string, 0, len(e.due))
+	for id := range e.due {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := e.due[ids[i]], e.due[ids[j]]
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return runSeq(ids[i]) < runSeq(ids[j])
+	})
+	var next time.Time
+	inflight := e.inflightLocked()
+	for _, id := range ids {
+		at, run := e.due[id], e.runs[id]
+		if at.After(now) {
+			if next.IsZero() || at.Before(next) {
+				next = at
+			}
+			continue
+		}
+		job := e.jobs[run.JobName]
+		if e.worker == nil || e.worker.draining || !e.worker.handlers[run.JobName] {
+			// 等 worker 注册该 job（Connect / Heartbeat 会唤醒循环）
+			continue
+		}
+		if limit := job.maxInflight(); limit > 0 && inflight[run.JobName] >= limit {
+			continue
+		}
+		inflight[run.JobName]++
+		sends = append(sends, outbound{e.worker, e.dispatchLocked(run, job)})
+	}
+	for _, je := range e.jobs {
+		if !je.next.IsZero() && je.active() && (next.IsZero() || je.next.Before(next)) {
+			next = je.next
+		}
+	}
+	if len(sends) > 0 {
+		e.notifyLocked()
+	}
+	e.mu.Unlock()
+
+	for _, s := range sends {
+		e.sendDispatch(s.sess, s.d)
+	}
+	return next
+}
+
+func (je *jobEntry) maxInflight() int {
+	if je == nil {
+		return 0
+	}
+	return int(je.job.MaxInflight)
+}
+
+// inflightLocked 各 job 已派发未结束的 run 数。
+func (e *Engine) inflightLocked() map[string]int {
+	n := make(map[string]int)
+	for _, run := range e.runs {
+		if run.Status == pb.RunStatus_RUN_STATUS_DISPATCHED || run.Status == pb.RunStatus_RUN_STATUS_RUNNING {
+			n[run.JobName]++
+		}
+	}
+	return n
+}
+
+// fireLocked 为 job 登记一次触发；EXECUTE_MODE_SHARDING 且 shard_total>1 时登记父 run 与各分片 run。返回父 run（或唯一 run）。
+func (e *Engine) fireLocked(job *pb.Job, tt pb.TriggerType, bizKey string, payload []byte, source string) *pb.Run {
+	newRun := func() *pb.Run {
+		return &pb.Run{
+			JobName:       job.JobName,
+			AppName:       job.AppName,
+			BizKey:        bizKey,
+			Payload:       payload,
+			TriggerType:   tt,
+			TriggerSource: source,
+		}
+	}
+	if job.ExecuteMode != pb.ExecuteMode_EXECUTE_MODE_SHARDING || job.ShardTotal <= 1 {
+		run := newRun()
+		e.enqueueLocked(run, time.Now())
+		return run
+	}
+	parent := newRun()
+	parent.ShardTotal = job.ShardTotal
+	e.registerRunLocked(parent)
+	parent.Status = pb.RunStatus_RUN_STATUS_RUNNING
+	e.putRunLocked(parent)
+	for i := range job.ShardTotal {
+		run := newRun()
+		run.ParentRunId = parent.RunId
+		run.ShardIndex = i
+		run.ShardTotal = job.ShardTotal
+		e.enqueueLocked(run, time.Now())
+	}
+	return parent
+}
+
+// registerRunLocked 分配 run_id 并登记为 PENDING，不排队。
+func (e *Engine) registerRunLocked(run *pb.Run) {
+	e.seq++
+	run.RunId = fmt.Sprintf("local-%d", e.seq)
+	run.Status = pb.RunStatus_RUN_STATUS_PENDING
+	run.CreatedAt = time.Now().Unix()
+	if run.AppName == "" {
+		run.AppName = e.opts.AppName
+	}
+	e.runs[run.RunId] = run
+	e.putRunLocked(run)
+	e.notifyLocked()
+}
+
+// enqueueLocked 登记 run 并在 at 时派发；at 晚于当前时间时 next_run_at 记为到期秒数。
+func (e *Engine) enqueueLocked(run *pb.Run, at time.Time) {
+	if at.After(time.Now()) {
+		run.NextRunAt = (at.UnixMilli() + 999) / 1000
+	}
+	e.registerRunLocked(run)
+	e.due[run.RunId] = at
+}
+
+// dispatchLocked 把到期 run 标记为 DISPATCHED 并构造 Dispatch；待重试的 run retry_count+1。
+func (e *Engine) dispatchLocked(run *pb.Run, je *jobEntry) *pb.Dispatch {
+	delete(e.due, run.RunId)
+	if run.Status != pb.RunStatus_RUN_STATUS_PENDING {
+		run.RetryCount++
+	}
+	var job *pb.Job
+	if je != nil {
+		job = je.job
+	}
+	priority := run.Priority
+	if priority == pb.Priority_PRIORITY_UNSPECIFIED {
+		priority = job.GetPriority()
+	}
+	run.Status = pb.RunStatus_RUN_STATUS_DISPATCHED
+	run.NextRunAt = 0
+	run.WorkerId = e.worker.workerID
+	run.DispatchedAt = time.Now().UnixMilli()
+	e.putRunLocked(run)
+	return &pb.Dispatch{
+		RunId:        run.RunId,
+		JobName:      run.JobName,
+		BizKey:       run.BizKey,
+		Payload:      run.Payload,
+		TimeoutSec:   job.GetTimeoutSeconds(),
+		RetryCount:   run.RetryCount,
+		RetryMax:     job.GetRetryMax(),
+		ShardIndex:   run.ShardIndex,
+		ShardTotal:   run.ShardTotal,
+		TriggerType:  run.TriggerType,
+		TraceId:      run.TraceId,
+		SpanId:       run.SpanId,
+		DispatchedAt: time.Now().Unix(),
+		Priority:     priority,
+		Checkpoint:   e.checkpoint[run.RunId],
+	}
+}
+
+// sendDispatch 发送失败（worker 已断开）时 run 重新排队，等 worker 重连。
+func (e *Engine) sendDispatch(sess *session, d *pb.Dispatch) {
+	if err := sess.send(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Dispatch{Dispatch: d}}); err != nil {
+		e.mu.Lock()
+		if run, ok := e.runs[d.RunId]; ok && run.Status == pb.RunStatus_RUN_STATUS_DISPATCHED {
+			run.Status = pb.RunStatus_RUN_STATUS_PENDING
+			e.due[d.RunId] = time.Now().Add(rejectRetryDelay)
+			e.putRunLocked(run)
+			e.notifyLocked()
+		}
+		e.mu.Unlock()
+	}
+}
+
+// ===== run 结果 =====
+
+// finishLocked 应用 JobResult：FAILED / TIMEOUT 在 retry_max 内按 retry_backoff 排队重试，用尽或 non_retriable 转 DEAD。
+func (e *Engine) finishLocked(r *pb.JobResult) {
+	run, ok := e.runs[r.RunId]
+	if !ok || runFinished(run) {
+		// 已取消 / 已结束的 run 忽略迟到的结果
+		return
+	}
+	run.Status = r.Status
+	run.Output = []byte(r.Output)
+	run.Error = r.Error
+	run.DurationMs = r.DurationMs
+	run.StartedAt = r.StartedAt
+	run.EndedAt = r.EndedAt
+	if r.Status == pb.RunStatus_RUN_STATUS_FAILED || r.Status == pb.RunStatus_RUN_STATUS_TIMEOUT {
+		retryMax := e.jobs[run.JobName].retryMax()
+		switch {
+		case r.NonRetriable:
+			run.Status = pb.RunStatus_RUN_STATUS_DEAD
+		case run.RetryCount < retryMax:
+			at := time.Now().Add(e.retryDelay(run, r))
+			run.NextRunAt = (at.UnixMilli() + 999) / 1000
+			e.due[run.RunId] = at
+		case retryMax > 0:
+			run.Status = pb.RunStatus_RUN_STATUS_DEAD
+		}
+	}
+	e.putRunLocked(run)
+	if runFinished(run) {
+		e.endLocked(run)
+		e.rollupShardsLocked(run)
+	}
+}
+
+func (je *jobEntry) retryMax() int32 {
+	if je == nil {
+		return 0
+	}
+	return je.job.RetryMax
+}
+
+// retryDelay 第 retry_count+1 次重试的间隔：JobResult.suggest_retry_after_sec 优先，
+// 其次 retry_backoff（JSON 秒数数组，次数超出数组长度时取最后一项），默认 10s。
+func (e *Engine) retryDelay(run *pb.Run, r *pb.JobResult) time.Duration {
+	if r.SuggestRetryAfterSec > 0 {
+		return time.Duration(r.SuggestRetryAfterSec) * time.Second
+	}
+	je := e.jobs[run.JobName]
+	if je == nil || je.job.RetryBackoff == "" {
+		return defaultRetryBackoff
+	}
+	var backoff []float64
+	if err := json.Unmarshal([]byte(je.job.RetryBackoff), &backoff); err != nil || len(backoff) == 0 {
+		return defaultRetryBackoff
+	}
+	sec := backoff[min(int(run.RetryCount), len(backoff)-1)]
+	return time.Duration(sec * float64(time.Second))
+}
+
+// endLocked 登记已结束的 run 并淘汰超出 MaxRuns 的最早记录。
+func (e *Engine) endLocked(run *pb.Run) {
+	delete(e.due, run.RunId)
+	if run.EndedAt == 0 {
+		run.EndedAt = time.Now().UnixMilli()
+	}
+	e.finished = append(e.finished, run.RunId)
+	e.trimLocked()
+}
+
+func (e *Engine) trimLocked() {
+	for len(e.finished) > e.opts.MaxRuns {
+		id := e.finished[0]
+		e.finished = e.finished[1:]
+		if run, ok := e.runs[id]; ok && runFinished(run) {
+			delete(e.runs, id)
+			delete(e.checkpoint, id)
+		}
+	}
+}
+
+// rollupShardsLocked 分片 run 结束后刷新父 run：全部结束时 SUCCESS（全部成功）或 FAILED，error 记录失败分片数。
+func (e *Engine) rollupShardsLocked(shard *pb.Run) {
+	parent, ok := e.runs[shard.ParentRunId]
+	if !ok || shard.ShardTotal == 0 || parent.ShardTotal == 0 || runFinished(parent) {
+		return
+	}
+	finished, failed := 0, 0
+	for _, r := range e.runs {
+		if r.ParentRunId != parent.RunId || r.ShardTotal == 0 || !runFinished(r) {
+			continue
+		}
+		finished++
+		if r.Status != pb.RunStatus_RUN_STATUS_SUCCESS {
+			failed++
+		}
+	}
+	if finished < int(parent.ShardTotal) {
+		return
+	}
+	parent.Status = pb.RunStatus_RUN_STATUS_SUCCESS
+	if failed > 0 {
+		parent.Status = pb.RunStatus_RUN_STATUS_FAILED
+		parent.Error = fmt.Sprintf("%d/%d shards failed", failed, parent.ShardTotal)
+	}
+	e.putRunLocked(parent)
+	e.endLocked(parent)
+}
+
+// ===== 持久化 =====
+
+func (e *Engine) putRunLocked(run *pb.Run) {
+	if e.hist != nil {
+		e.hist.putRun(run)
+		e.compactIfNeededLocked()
+	}
+}
+
+func (e *Engine) putJobLocked(job *pb.Job) {
+	if e.hist != nil {
+		e.hist.putJob(job)
+		e.compactIfNeededLocked()
+	}
+}
+
+func (e *Engine) compactIfNeededLocked() {
+	if !e.hist.needCompact(len(e.jobs) + len(e.runs)) {
+		return
+	}
+	if err := e.hist.compact(e.jobs, e.runs); err != nil {
+		logger.Warnf("[scheduler-sdk] local history: %v", err)
+	}
+}
+
+// runFinished 与 SDK 一致：SUCCESS / DEAD / CANCELED，或 FAILED / TIMEOUT / DISPATCH_FAIL 且没有待执行的重试。
+func runFinished(run *pb.Run) bool {
+	switch run.Status {
+	case pb.RunStatus_RUN_STATUS_SUCCESS, pb.RunStatus_RUN_STATUS_DEAD, pb.RunStatus_RUN_STATUS_CANCELED:
+		return true
+	case pb.RunStatus_RUN_STATUS_FAILED, pb.RunStatus_RUN_STATUS_TIMEOUT, pb.RunStatus_RUN_STATUS_DISPATCH_FAIL:
+		return run.NextRunAt == 0
+	default:
+		return false
+	}
+}
+
+// runSeq 取 "local-N" 的序号用于排序。
+func runSeq(runID string) int64 {
+	var n int64
+	_, _ = fmt.Sscanf(strings.TrimPrefix(runID, "local-"), "%d", &n)
+	return n
+}
+
+func cloneRun(run *pb.Run) *pb.Run { return proto.Clone(run).(*pb.Run) }
+
+func cloneJob(job *pb.Job) *pb.Job { return proto.Clone(job).(*pb.Job) }
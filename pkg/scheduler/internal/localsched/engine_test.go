@@ -0,0 +1,73 @@
+package localsched
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// newTestEngine 不启动调度循环与 gRPC 服务的 Engine，用于直接驱动 tick。
+func newTestEngine() *Engine {
+	return &Engine{
+		opts:       Options{AppName: "local", MaxRuns: defaultMaxRuns},
+		changed:    make(chan struct{}),
+		jobs:       make(map[string]*jobEntry),
+		runs:       make(map[string]*pb.Run),
+		due:        make(map[string]time.Time),
+		checkpoint: make(map[string][]byte),
+		dedup:      make(map[string]dedupEntry),
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+func TestEngine_MisfirePolicy(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 30, 0, time.UTC)
+	for policy, wantRuns := range map[string]int{"": 0, MisfireSkip: 0, MisfireFireOnce: 1} {
+		e := newTestEngine()
+		je, err := newJobEntry(&pb.Job{
+			JobName:       "report",
+			TriggerType:   pb.TriggerType_TRIGGER_TYPE_CRON,
+			CronExpr:      "0 * * * * *",
+			Timezone:      "UTC",
+			MisfirePolicy: policy,
+		})
+		if err != nil {
+			t.Fatalf("newJobEntry: %v", err)
+		}
+		// 进程停止期间错过了 10 次触发
+		je.next = now.Add(-10 * time.Minute)
+		e.jobs["report"] = je
+
+		next := e.tick(now)
+		if len(e.runs) != wantRuns {
+			t.Fatalf("policy %q: runs=%d want %d", policy, len(e.runs), wantRuns)
+		}
+		if want := time.Date(2026, 1, 1, 12, 1, 0, 0, time.UTC); !je.next.Equal(want) || !next.Equal(want) && wantRuns == 0 {
+			t.Fatalf("policy %q: next=%s tick next=%s want %s", policy, je.next, next, want)
+		}
+	}
+}
+
+func TestEngine_RetryDelay(t *testing.T) {
+	e := newTestEngine()
+	e.jobs["a"] = &jobEntry{job: &pb.Job{JobName: "a", RetryBackoff: "[1, 5, 30]"}}
+	e.jobs["b"] = &jobEntry{job: &pb.Job{JobName: "b", RetryBackoff: "not json"}}
+	cases := []struct {
+		run  *pb.Run
+		res  *pb.JobResult
+		want time.Duration
+	}{
+		{&pb.Run{JobName: "a"}, &pb.JobResult{}, time.Second},
+		{&pb.Run{JobName: "a", RetryCount: 1}, &pb.JobResult{}, 5 * time.Second},
+		{&pb.Run{JobName: "a", RetryCount: 7}, &pb.JobResult{}, 30 * time.Second},
+		{&pb.Run{JobName: "a"}, &pb.JobResult{SuggestRetryAfterSec: 3}, 3 * time.Second},
+		{&pb.Run{JobName: "b"}, &pb.JobResult{}, defaultRetryBackoff},
+		{&pb.Run{JobName: "missing"}, &pb.JobResult{}, defaultRetryBackoff},
+	}
+	for i, tc := range cases {
+		if got := e.retryDelay(tc.run, tc.res); got != tc.want {
+			t.Errorf("case %d: delay=%s want %s", i, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package localsched
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// history 文件持久化的 job / run 记录：每次变化追加一行 JSON，同一 job / run 以最后一条为准；
+// 追加记录远多于存活对象时整体重写（compact），文件大小与保留的 run 数同阶。
+type history struct {
+	path    string
+	f       *os.File
+	records int // 文件中的记录条数
+}
+
+// historyRecord 一行记录；Job / Run 为 protojson 编码，DeleteJob 为被删除的 job 名。
+type historyRecord struct {
+	Job       json.RawMessage `json:"job,omitempty"`
+	Run       json.RawMessage `json:"run,omitempty"`
+	DeleteJob string          `json:"delete_job,omitempty"`
+}
+
+// openHistory 读取 path 中的全部记录后以追加模式打开；文件不存在时新建。
+// 进程崩溃留下的不完整末行会被忽略。
+func openHistory(path string) (*history, map[string]*pb.Job, map[string]*pb.Run, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, nil, nil, fmt.Errorf("localsched: history dir: %w", err)
+	}
+	jobs := make(map[string]*pb.Job)
+	runs := make(map[string]*pb.Run)
+	h := &history{path: path}
+	f, err := os.Open(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, nil, nil, fmt.Errorf("localsched: open history: %w", err)
+	default:
+		err = h.load(f, jobs, runs)
+		_ = f.Close()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if h.f, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644); err != nil {
+		return nil, nil, nil, fmt.Errorf("localsched: open history: %w", err)
+	}
+	return h, jobs, runs, nil
+}
+
+func (h *history) load(r io.Reader, jobs map[string]*pb.Job, runs map[string]*pb.Run) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for sc.Scan() {
+		var rec historyRecord
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			logger.Warnf("[scheduler-sdk] local history %s: skip bad record: %v", h.path, err)
+			continue
+		}
+		h.records++
+		switch {
+		case rec.DeleteJob != "":
+			delete(jobs, rec.DeleteJob)
+		case len(rec.Job) > 0:
+			job := &pb.Job{}
+			if err := protojson.Unmarshal(rec.Job, job); err == nil {
+				jobs[job.JobName] = job
+			}
+		case len(rec.Run) > 0:
+			run := &pb.Run{}
+			if err := protojson.Unmarshal(rec.Run, run); err == nil {
+				runs[run.RunId] = run
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("localsched: read history: %w", err)
+	}
+	return nil
+}
+
+func (h *history) putJob(job *pb.Job) {
+	b, err := protojson.Marshal(job)
+	if err == nil {
+		h.append(historyRecord{Job: b})
+	}
+}
+
+func (h *history) putRun(run *pb.Run) {
+	b, err := protojson.Marshal(run)
+	if err == nil {
+		h.append(historyRecord{Run: b})
+	}
+}
+
+func (h *history) deleteJob(name string) {
+	h.append(historyRecord{DeleteJob: name})
+}
+
+// append 写失败只记日志：历史文件是尽力而为的持久化，不影响内存中的调度。
+func (h *history) append(rec historyRecord) {
+	line, _ := json.Marshal(rec)
+	if _, err := h.f.Write(append(line, '\n')); err != nil {
+		logger.Warnf("[scheduler-sdk] local history %s: write: %v", h.path, err)
+		return
+	}
+	h.records++
+}
+
+// needCompact 追加记录超过存活对象 2 倍（且至少 1024 条）时需要重写。
+func (h *history) needCompact(live int) bool {
+	return h.records > 2*live && h.records > 1024
+}
+
+// compact 把当前存活的 job / run 写入临时文件后原子替换原文件。
+func (h *history) compact(jobs map[string]*jobEntry, runs map[string]*pb.Run) error {
+	tmp := h.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("localsched: compact history: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	n := 0
+	put := func(rec historyRecord) {
+		line, _ := json.Marshal(rec)
+		_, _ = w.Write(append(line, '\n'))
+		n++
+	}
+	for _, je := range jobs {
+		if b, err := protojson.Marshal(je.job); err == nil {
+			put(historyRecord{Job: b})
+		}
+	}
+	for _, run := range runs {
+		if b, err := protojson.Marshal(run); err == nil {
+			put(historyRecord{Run: b})
+		}
+	}
+	err = w.Flush()
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp, h.path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("localsched: compact history: %w", err)
+	}
+	nf, err := os.OpenFile(h.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("localsched: reopen history: %w", err)
+	}
+	_ = h.f.Close()
+	h.f, h.records = nf, n
+	return nil
+}
+
+func (h *history) close() error {
+	err := h.f.Sync()
+	if cerr := h.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,401 @@
+package localsched
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SchedulerService 的本地实现：只覆盖 run / job 管理（SubmitTask(s) / GetRun / WatchRun / CancelRun / ListRuns / RetryRun /
+// GetJob / CreateJob / UpdateJob / DeleteJob / ListJobs / PauseJob / ResumeJob / TriggerJob），
+// 其余 RPC 返回 Unimplemented。本地模式没有双人复核，UpdateJob 的 require_approval 被忽略。
+
+// maxSubmitBatch 单次 SubmitTasks 的条目上限，与服务端一致。
+const maxSubmitBatch = 500
+
+// SubmitTask biz_key 去重 → 登记 PENDING run；run_at 晚于当前时间时到期再派发。
+func (e *Engine) SubmitTask(_ context.Context, req *pb.SubmitTaskRequest) (*pb.SubmitTaskResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.submitLocked(&pb.SubmitTaskItem{
+		JobName:         req.JobName,
+		BizKey:          req.BizKey,
+		Payload:         req.Payload,
+		DedupeWindowSec: req.DedupeWindowSec,
+		TraceId:         req.TraceId,
+		SpanId:          req.SpanId,
+		RunAt:           req.RunAt,
+		Priority:        req.Priority,
+	})
+}
+
+// SubmitTasks 批量版 SubmitTask，单条失败写入对应结果的 code / error。
+func (e *Engine) SubmitTasks(_ context.Context, req *pb.SubmitTasksRequest) (*pb.SubmitTasksResponse, error) {
+	if len(req.Tasks) > maxSubmitBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tasks per batch, got %d", maxSubmitBatch, len(req.Tasks))
+	}
+	resp := &pb.SubmitTasksResponse{Results: make([]*pb.SubmitTaskResult, len(req.Tasks))}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, item := range req.Tasks {
+		r, err := e.submitLocked(item)
+		if err != nil {
+			st := status.Convert(err)
+			resp.Results[i] = &pb.SubmitTaskResult{Code: int32(st.Code()), Error: st.Message()}
+			continue
+		}
+		resp.Results[i] = &pb.SubmitTaskResult{RunId: r.RunId, Status: r.Status, Dedup: r.Dedup}
+	}
+	return resp, nil
+}
+
+func (e *Engine) submitLocked(item *pb.SubmitTaskItem) (*pb.SubmitTaskResponse, error) {
+	if item.JobName == "" {
+		return nil, status.Error(codes.InvalidArgument, "job_name required")
+	}
+	now := time.Now()
+	dedupKey := item.JobName + "\x00" + item.BizKey
+	if item.BizKey != "" && item.DedupeWindowSec > 0 {
+		if d, ok := e.dedup[dedupKey]; ok && now.Before(d.expiresAt) {
+			if run, ok := e.runs[d.runID]; ok {
+				return &pb.SubmitTaskResponse{RunId: run.RunId, Status: run.Status, Dedup: true}, nil
+			}
+		}
+		for k, d := range e.dedup {
+			if !now.Before(d.expiresAt) {
+				delete(e.dedup, k)
+			}
+		}
+	}
+	run := &pb.Run{
+		JobName:     item.JobName,
+		BizKey:      item.BizKey,
+		Payload:     item.Payload,
+		TriggerType: pb.TriggerType_TRIGGER_TYPE_API,
+		TraceId:     item.TraceId,
+		SpanId:      item.SpanId,
+		Priority:    item.Priority,
+	}
+	at := now
+	if item.RunAt > 0 {
+		at = time.UnixMilli(item.RunAt)
+	}
+	e.enqueueLocked(run, at)
+	if item.BizKey != "" && item.DedupeWindowSec > 0 {
+		e.dedup[dedupKey] = dedupEntry{runID: run.RunId, expiresAt: now.Add(time.Duration(item.DedupeWindowSec) * time.Second)}
+	}
+	return &pb.SubmitTaskResponse{RunId: run.RunId, Status: pb.RunStatus_RUN_STATUS_PENDING}, nil
+}
+
+// GetRun 返回 run 当前状态的副本。
+func (e *Engine) GetRun(_ context.Context, req *pb.GetRunRequest) (*pb.Run, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	run, ok := e.runs[req.RunId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+	}
+	return cloneRun(run), nil
+}
+
+// CancelRun 把未结束的 run 标记为 CANCELED；执行中的 run 同时向 worker 推送 Cancel。
+func (e *Engine) CancelRun(_ context.Context, req *pb.CancelRunRequest) (*pb.CancelRunResponse, error) {
+	e.mu.Lock()
+	run, ok := e.runs[req.RunId]
+	if !ok {
+		e.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+	}
+	if runFinished(run) {
+		e.mu.Unlock()
+		return &pb.CancelRunResponse{Ok: false, Error: "run already finished: " + run.Status.String()}, nil
+	}
+	running := run.Status == pb.RunStatus_RUN_STATUS_DISPATCHED || run.Status == pb.RunStatus_RUN_STATUS_RUNNING
+	run.Status = pb.RunStatus_RUN_STATUS_CANCELED
+	run.Error = req.Reason
+	run.NextRunAt = 0
+	e.putRunLocked(run)
+	e.endLocked(run)
+	e.rollupShardsLocked(run)
+	e.notifyLocked()
+	sess := e.worker
+	e.mu.Unlock()
+	if running && sess != nil {
+		_ = sess.send(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Cancel{
+			Cancel: &pb.Cancel{RunId: req.RunId, Reason: req.Reason},
+		}})
+	}
+	return &pb.CancelRunResponse{Ok: true}, nil
+}
+
+// WatchRun 先推送 run 当前快照，之后每次变化推送一次，run 结束后关闭流。
+func (e *Engine) WatchRun(req *pb.WatchRunRequest, stream pb.SchedulerService_WatchRunServer) error {
+	ctx := stream.Context()
+	var last *pb.Run
+	for {
+		e.mu.Lock()
+		run, ok := e.runs[req.RunId]
+		if !ok {
+			e.mu.Unlock()
+			return status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+		}
+		var snap *pb.Run
+		if last == nil || !proto.Equal(last, run) {
+			snap = cloneRun(run)
+		}
+		changed := e.changed
+		e.mu.Unlock()
+
+		if snap != nil {
+			if err := stream.Send(&pb.RunEvent{Run: snap}); err != nil {
+				return err
+			}
+			if runFinished(snap) {
+				return nil
+			}
+			last = snap
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ListRuns 按 job_name / app_name / status / biz_key_like / worker_id / parent_run_id / since / until 过滤，按 run_id 升序分页。
+func (e *Engine) ListRuns(_ context.Context, req *pb.ListRunsRequest) (*pb.ListRunsResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	matched := make([]*pb.Run, 0, len(e.runs))
+	for _, r := range e.runs {
+		switch {
+		case req.JobName != "" && r.JobName != req.JobName,
+			req.AppName != "" && r.AppName != req.AppName,
+			req.Status != pb.RunStatus_RUN_STATUS_UNSPECIFIED && r.Status != req.Status,
+			req.BizKeyLike != "" && !strings.Contains(r.BizKey, req.BizKeyLike),
+			req.WorkerId != "" && r.WorkerId != req.WorkerId,
+			req.ParentRunId != "" && r.ParentRunId != req.ParentRunId,
+			req.Since > 0 && r.CreatedAt < req.Since,
+			req.Until > 0 && r.CreatedAt > req.Until:
+			continue
+		}
+		matched = append(matched, cloneRun(r))
+	}
+	sort.Slice(matched, func(i, j int) bool { return runSeq(matched[i].RunId) < runSeq(matched[j].RunId) })
+	return &pb.ListRunsResponse{Runs: pageOf(matched, req.Page, req.PageSize), Total: int64(len(matched))}, nil
+}
+
+// RetryRun 以原 run 的参数新建一条 run（trigger_type=retry，parent_run_id 指向原 run），并继承原 run 的 checkpoint。
+func (e *Engine) RetryRun(_ context.Context, req *pb.RetryRunRequest) (*pb.RetryRunResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	old, ok := e.runs[req.RunId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+	}
+	if !runFinished(old) {
+		return nil, status.Errorf(codes.FailedPrecondition, "run %s not finished", req.RunId)
+	}
+	run := &pb.Run{
+		JobName:     old.JobName,
+		AppName:     old.AppName,
+		BizKey:      old.BizKey,
+		Payload:     old.Payload,
+		TriggerType: pb.TriggerType_TRIGGER_TYPE_RETRY,
+		ParentRunId: old.RunId,
+		TraceId:     old.TraceId,
+		SpanId:      old.SpanId,
+		Priority:    old.Priority,
+	}
+	e.enqueueLocked(run, time.Now())
+	if cp, ok := e.checkpoint[old.RunId]; ok {
+		e.checkpoint[run.RunId] = cp
+	}
+	return &pb.RetryRunResponse{NewRunId: run.RunId}, nil
+}
+
+// ===== job =====
+
+// GetJob 返回 job；不存在返回 NotFound（EnsureJob 据此进入 CreateJob）。
+func (e *Engine) GetJob(_ context.Context, req *pb.GetJobRequest) (*pb.Job, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	je, ok := e.jobs[req.JobName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", req.JobName)
+	}
+	return cloneJob(je.job), nil
+}
+
+// CreateJob 登记 job 并开始按触发配置调度；cron_expr / timezone 非法返回 InvalidArgument。
+func (e *Engine) CreateJob(_ context.Context, req *pb.CreateJobRequest) (*pb.Job, error) {
+	if req.Job == nil || req.Job.JobName == "" {
+		return nil, status.Error(codes.InvalidArgument, "job.job_name required")
+	}
+	job := cloneJob(req.Job)
+	je, err := newJobEntry(job)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "job %s: %v", job.JobName, err)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.jobs[job.JobName]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "job %s already exists", job.JobName)
+	}
+	now := time.Now()
+	e.jobSeq++
+	job.Id = e.jobSeq
+	if job.AppName == "" {
+		job.AppName = e.opts.AppName
+	}
+	job.Status = "waiting_worker"
+	if e.worker != nil && e.worker.handlers[job.JobName] {
+		job.Status = "ready"
+	}
+	job.CreatedAt, job.UpdatedAt = now.Unix(), now.Unix()
+	je.schedule(now)
+	e.jobs[job.JobName] = je
+	e.putJobLocked(job)
+	e.notifyLocked()
+	return cloneJob(job), nil
+}
+
+// UpdateJob 覆盖 job 配置；触发配置变化时从当前时间重新计算下次触发。
+func (e *Engine) UpdateJob(_ context.Context, req *pb.UpdateJobRequest) (*pb.Job, error) {
+	if req.Job == nil || req.Job.JobName == "" {
+		return nil, status.Error(codes.InvalidArgument, "job.job_name required")
+	}
+	next := cloneJob(req.Job)
+	nje, err := newJobEntry(next)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "job %s: %v", next.JobName, err)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	je, ok := e.jobs[next.JobName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", next.JobName)
+	}
+	old := je.job
+	next.Id, next.AppName, next.Status = old.Id, old.AppName, old.Status
+	next.CreatedAt, next.CreatedBy = old.CreatedAt, old.CreatedBy
+	next.UpdatedAt = time.Now().Unix()
+	if sameTrigger(old, next) {
+		nje.next, next.NextRunAt = je.next, old.NextRunAt
+	} else {
+		nje.schedule(time.Now())
+	}
+	e.jobs[next.JobName] = nje
+	e.putJobLocked(next)
+	e.notifyLocked()
+	return cloneJob(next), nil
+}
+
+func sameTrigger(a, b *pb.Job) bool {
+	return a.TriggerType == b.TriggerType && a.CronExpr == b.CronExpr && a.Timezone == b.Timezone &&
+		a.FixedRateSeconds == b.FixedRateSeconds && a.OneTimeAt == b.OneTimeAt
+}
+
+// DeleteJob 删除 job（已登记的 run 保留）；confirm 须等于 job 名，否则返回 ok=false。
+func (e *Engine) DeleteJob(_ context.Context, req *pb.DeleteJobRequest) (*pb.DeleteJobResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.jobs[req.JobName]; !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", req.JobName)
+	}
+	if req.Confirm != req.JobName {
+		return &pb.DeleteJobResponse{Ok: false, Error: "confirm must equal job_name"}, nil
+	}
+	delete(e.jobs, req.JobName)
+	if e.hist != nil {
+		e.hist.deleteJob(req.JobName)
+	}
+	e.notifyLocked()
+	return &pb.DeleteJobResponse{Ok: true}, nil
+}
+
+// ListJobs 按 app_name / keyword / trigger_type / status / priority 过滤，按 job_name 升序分页。
+func (e *Engine) ListJobs(_ context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	matched := make([]*pb.Job, 0, len(e.jobs))
+	for _, je := range e.jobs {
+		j := je.job
+		switch {
+		case req.AppName != "" && j.AppName != req.AppName,
+			req.Keyword != "" && !strings.Contains(j.JobName, req.Keyword) && !strings.Contains(j.Description, req.Keyword),
+			req.TriggerType != pb.TriggerType_TRIGGER_TYPE_UNSPECIFIED && j.TriggerType != req.TriggerType,
+			req.Status != "" && j.Status != req.Status,
+			req.Priority != pb.Priority_PRIORITY_UNSPECIFIED && j.Priority != req.Priority:
+			continue
+		}
+		matched = append(matched, cloneJob(j))
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].JobName < matched[j].JobName })
+	return &pb.ListJobsResponse{Jobs: pageOf(matched, req.Page, req.PageSize), Total: int64(len(matched))}, nil
+}
+
+// PauseJob 暂停自动触发；已登记的 run 照常执行。
+func (e *Engine) PauseJob(_ context.Context, req *pb.PauseJobRequest) (*pb.Job, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	je, ok := e.jobs[req.JobName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", req.JobName)
+	}
+	je.job.Status = "paused"
+	je.job.UpdatedAt = time.Now().Unix()
+	e.putJobLocked(je.job)
+	e.notifyLocked()
+	return cloneJob(je.job), nil
+}
+
+// ResumeJob 恢复自动触发，暂停期间错过的触发不补跑。
+func (e *Engine) ResumeJob(_ context.Context, req *pb.ResumeJobRequest) (*pb.Job, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	je, ok := e.jobs[req.JobName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", req.JobName)
+	}
+	now := time.Now()
+	je.job.Status = "ready"
+	je.job.UpdatedAt = now.Unix()
+	je.schedule(now)
+	e.markWaitingLocked()
+	e.putJobLocked(je.job)
+	e.notifyLocked()
+	return cloneJob(je.job), nil
+}
+
+// TriggerJob 手动触发一次（trigger_type=manual）；分片 job 登记父 run 与各分片 run，返回父 run_id。
+func (e *Engine) TriggerJob(_ context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	je, ok := e.jobs[req.JobName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", req.JobName)
+	}
+	run := e.fireLocked(je.job, pb.TriggerType_TRIGGER_TYPE_MANUAL, req.BizKey, req.Payload, req.Operator)
+	return &pb.TriggerJobResponse{RunId: run.RunId}, nil
+}
+
+// pageOf 取第 page 页（从 1 开始）；pageSize<=0 时返回全部。
+func pageOf[T any](items []T, page, pageSize int32) []T {
+	if pageSize <= 0 {
+		return items
+	}
+	page = max(page, 1)
+	start := int(page-1) * int(pageSize)
+	if start >= len(items) {
+		return nil
+	}
+	return items[start:min(start+int(pageSize), len(items))]
+}
@@ -0,0 +1,140 @@
+package localsched
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// session 本地 Client 的 Connect 流；send 需加锁，grpc ServerStream.Send 不支持并发调用。
+type session struct {
+	workerID string
+	handlers map[string]bool
+	stream   pb.WorkerService_ConnectServer
+	sendMu   sync.Mutex
+	draining bool // 受 Engine.mu 保护
+}
+
+func (s *session) send(msg *pb.SchedulerMessage) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.stream.Send(msg)
+}
+
+// Connect 实现 WorkerService.Connect。本地模式只有同进程的一个 Client，新连接替换旧连接；
+// 不校验签名（bufconn 不会被其他进程访问）。
+func (e *Engine) Connect(stream pb.WorkerService_ConnectServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	reg := first.GetRegister()
+	if reg == nil {
+		return status.Error(codes.InvalidArgument, "first message must be RegisterRequest")
+	}
+	sess := &session{
+		workerID: reg.WorkerId,
+		handlers: make(map[string]bool, len(reg.HandlerJobs)),
+		stream:   stream,
+		draining: reg.Draining,
+	}
+	for _, j := range reg.HandlerJobs {
+		sess.handlers[j] = true
+	}
+	if err := sess.send(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Register{
+		Register: &pb.RegisterResponse{
+			Ok:                true,
+			ServerTs:          time.Now().Unix(),
+			HeartbeatInterval: e.opts.HeartbeatIntervalSec,
+			SchedulerLeader:   "local",
+		},
+	}}); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	e.worker = sess
+	e.markWaitingLocked()
+	e.notifyLocked()
+	e.mu.Unlock()
+	defer func() {
+		e.mu.Lock()
+		if e.worker == sess {
+			e.worker = nil
+			e.markWaitingLocked()
+		}
+		e.mu.Unlock()
+	}()
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		e.onWorkerMessage(sess, msg)
+	}
+}
+
+// markWaitingLocked 按当前 worker 注册的 handler 刷新 job 状态：ready / waiting_worker（paused / disabled 不变）。
+func (e *Engine) markWaitingLocked() {
+	for name, je := range e.jobs {
+		if !je.active() {
+			continue
+		}
+		st := "waiting_worker"
+		if e.worker != nil && e.worker.handlers[name] {
+			st = "ready"
+		}
+		if je.job.Status != st {
+			je.job.Status = st
+			e.putJobLocked(je.job)
+		}
+	}
+}
+
+func (e *Engine) onWorkerMessage(sess *session, msg *pb.WorkerMessage) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch p := msg.Payload.(type) {
+	case *pb.WorkerMessage_Heartbeat:
+		if p.Heartbeat.Draining == sess.draining {
+			return
+		}
+		sess.draining = p.Heartbeat.Draining
+	case *pb.WorkerMessage_Ack:
+		run, ok := e.runs[p.Ack.RunId]
+		if !ok || run.Status != pb.RunStatus_RUN_STATUS_DISPATCHED {
+			return
+		}
+		if p.Ack.Accepted {
+			run.Status = pb.RunStatus_RUN_STATUS_RUNNING
+		} else {
+			// worker 拒收（并发 / 队列已满、draining）：稍后重新派发
+			run.Status = pb.RunStatus_RUN_STATUS_PENDING
+			run.Error = p.Ack.Reason
+			e.due[run.RunId] = time.Now().Add(rejectRetryDelay)
+		}
+		e.putRunLocked(run)
+	case *pb.WorkerMessage_Result:
+		e.finishLocked(p.Result)
+	case *pb.WorkerMessage_Progress:
+		pg := p.Progress
+		if len(pg.Checkpoint) > 0 {
+			e.checkpoint[pg.RunId] = pg.Checkpoint
+		}
+		run, ok := e.runs[pg.RunId]
+		if !ok {
+			return
+		}
+		// 进度只更新内存，结束时随 run 一并落盘
+		run.ProgressPercent = pg.Percent
+		run.ProgressMessage = pg.Message
+		run.ProgressAt = pg.Ts
+	default:
+		return
+	}
+	e.notifyLocked()
+}
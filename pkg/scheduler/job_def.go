@@ -0,0 +1,186 @@
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	yaml "gopkg.in/yaml.v3"
+)
+
+// ErrInvalidJobDef JobDef 校验失败；SyncJobs 在发起任何 RPC 之前返回。
+var ErrInvalidJobDef = errors.New("scheduler: invalid job definition")
+
+// JobDef 声明式 job 定义，随业务仓库提交，由 SyncJobs 同步到 scheduler。
+//
+// 时长字段使用 Go duration 字符串（"30s" / "5m"）。除 cronExpr / fixedRate / critical 外，
+// 留空的字段不参与比对，保留服务端现值（服务端默认值或运维在 UI 上的调整）。
+type JobDef struct {
+	Name        string `yaml:"name"`        // job_name，必填
+	Description string `yaml:"description"` // 描述
+
+	// 触发方式：cronExpr 与 fixedRate 二选一；都为空表示仅 SubmitTask 触发（TRIGGER_TYPE_API）
+	CronExpr      string `yaml:"cronExpr"`      // cron 表达式，5 段或含秒的 6 段
+	FixedRate     string `yaml:"fixedRate"`     // 固定频率，如 "10m"
+	Timezone      string `yaml:"timezone"`      // cron 时区，如 Asia/Shanghai
+	MisfirePolicy string `yaml:"misfirePolicy"` // 错过触发时的补偿策略，取值与服务端一致
+
+	Timeout      string   `yaml:"timeout"`      // 单次执行超时，如 "5m"
+	MaxInflight  int32    `yaml:"maxInflight"`  // 全局最大并发 run
+	RetryMax     int32    `yaml:"retryMax"`     // 最大重试次数
+	RetryBackoff []string `yaml:"retryBackoff"` // 各次重试间隔，如 ["10s", "1m", "5m"]
+
+	ExecuteMode string `yaml:"executeMode"` // single / sharding
+	ShardTotal  int32  `yaml:"shardTotal"`  // executeMode=sharding 时必填
+
+	Priority string `yaml:"priority"` // high / normal / low
+	Critical bool   `yaml:"critical"` // 关键 job：配置变更走双人复核
+}
+
+// jobDefFile YAML 文件的顶层结构。
+type jobDefFile struct {
+	Jobs []JobDef `yaml:"jobs"`
+}
+
+// LoadJobDefs 读取 YAML 文件中的 jobs 列表并逐条校验。文件格式：
+//
+//	jobs:
+//	  - name: daily-report
+//	    cronExpr: "0 0 8 * * *"
+//	    timezone: Asia/Shanghai
+//	    timeout: 10m
+//	    retryMax: 3
+//	    retryBackoff: [30s, 2m, 10m]
+//	    critical: true
+func LoadJobDefs(path string) ([]JobDef, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler: read job defs: %w", err)
+	}
+	return ParseJobDefs(data)
+}
+
+// ParseJobDefs 解析 YAML 内容，未知字段视为错误（避免拼错的字段被静默忽略）。
+func ParseJobDefs(data []byte) ([]JobDef, error) {
+	var f jobDefFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidJobDef, err)
+	}
+	if err := validateJobDefs(f.Jobs); err != nil {
+		return nil, err
+	}
+	return f.Jobs, nil
+}
+
+// validateJobDefs 校验每条定义并检查重名。
+func validateJobDefs(defs []JobDef) error {
+	seen := make(map[string]bool, len(defs))
+	for i := range defs {
+		if _, err := defs[i].toJob(""); err != nil {
+			return err
+		}
+		if seen[defs[i].Name] {
+			return fmt.Errorf("%w: duplicate job %s", ErrInvalidJobDef, defs[i].Name)
+		}
+		seen[defs[i].Name] = true
+	}
+	return nil
+}
+
+// toJob 把定义转换为 pb.Job；appName 为空时由服务端取调用方应用。
+func (d *JobDef) toJob(appName string) (*pb.Job, error) {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: job %q: %s", ErrInvalidJobDef, d.Name, fmt.Sprintf(format, args...))
+	}
+	if d.Name == "" {
+		return nil, fmt.Errorf("%w: name required", ErrInvalidJobDef)
+	}
+	job := &pb.Job{
+		JobName:       d.Name,
+		AppName:       appName,
+		Description:   d.Description,
+		CronExpr:      d.CronExpr,
+		Timezone:      d.Timezone,
+		MisfirePolicy: d.MisfirePolicy,
+		MaxInflight:   d.MaxInflight,
+		RetryMax:      d.RetryMax,
+		ShardTotal:    d.ShardTotal,
+		Critical:      d.Critical,
+	}
+
+	switch {
+	case d.CronExpr != "" && d.FixedRate != "":
+		return nil, invalid("cronExpr and fixedRate are mutually exclusive")
+	case d.CronExpr != "":
+		job.TriggerType = pb.TriggerType_TRIGGER_TYPE_CRON
+	case d.FixedRate != "":
+		rate, err := time.ParseDuration(d.FixedRate)
+		if err != nil || rate < time.Second {
+			return nil, invalid("fixedRate %q must be a duration >= 1s", d.FixedRate)
+		}
+		job.TriggerType = pb.TriggerType_TRIGGER_TYPE_FIXED_RATE
+		job.FixedRateSeconds = int32(rate / time.Second)
+	default:
+		job.TriggerType = pb.TriggerType_TRIGGER_TYPE_API
+	}
+	// cronExpr / timezone 按 pkg/scheduler/cron 解析
+	if err := ValidateJob(job); err != nil {
+		return nil, err
+	}
+
+	if d.Timeout != "" {
+		timeout, err := time.ParseDuration(d.Timeout)
+		if err != nil || timeout < time.Second {
+			return nil, invalid("timeout %q must be a duration >= 1s", d.Timeout)
+		}
+		job.TimeoutSeconds = int32(timeout / time.Second)
+	}
+	if d.MaxInflight < 0 || d.RetryMax < 0 || d.ShardTotal < 0 {
+		return nil, invalid("maxInflight / retryMax / shardTotal must be >= 0")
+	}
+	if len(d.RetryBackoff) > 0 {
+		secs := make([]int64, 0, len(d.RetryBackoff))
+		for _, s := range d.RetryBackoff {
+			backoff, err := time.ParseDuration(s)
+			if err != nil || backoff < time.Second {
+				return nil, invalid("retryBackoff %q must be a duration >= 1s", s)
+			}
+			secs = append(secs, int64(backoff/time.Second))
+		}
+		b, _ := json.Marshal(secs)
+		job.RetryBackoff = string(b)
+	}
+
+	switch strings.ToLower(d.ExecuteMode) {
+	case "":
+	case "single":
+		job.ExecuteMode = pb.ExecuteMode_EXECUTE_MODE_SINGLE
+	case "sharding":
+		if d.ShardTotal <= 0 {
+			return nil, invalid("executeMode=sharding requires shardTotal > 0")
+		}
+		job.ExecuteMode = pb.ExecuteMode_EXECUTE_MODE_SHARDING
+	default:
+		return nil, invalid("unknown executeMode %q", d.ExecuteMode)
+	}
+
+	switch strings.ToLower(d.Priority) {
+	case "":
+	case "high":
+		job.Priority = pb.Priority_PRIORITY_HIGH
+	case "normal":
+		job.Priority = pb.Priority_PRIORITY_NORMAL
+	case "low":
+		job.Priority = pb.Priority_PRIORITY_LOW
+	default:
+		return nil, invalid("unknown priority %q", d.Priority)
+	}
+	return job, nil
+}
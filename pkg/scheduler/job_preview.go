@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/cron"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// ValidateJob 在本地校验 job 的触发配置：timezone 须为合法 IANA 时区，CRON 的 cron_expr 须可解析
+// （语法见 pkg/scheduler/cron），FIXED_RATE / ONE_TIME 须设置 fixed_rate_seconds / one_time_at。
+// 校验失败返回包装 ErrInvalidJobDef 的错误；EnsureJob、SyncJobs 在发起 RPC 之前调用。
+func ValidateJob(job *pb.Job) error {
+	if job == nil || job.JobName == "" {
+		return fmt.Errorf("%w: job_name required", ErrInvalidJobDef)
+	}
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: job %q: %s", ErrInvalidJobDef, job.JobName, fmt.Sprintf(format, args...))
+	}
+	if _, err := cron.LoadLocation(job.Timezone); err != nil {
+		return invalid("%v", err)
+	}
+	switch job.TriggerType {
+	case pb.TriggerType_TRIGGER_TYPE_CRON:
+		if job.CronExpr == "" {
+			return invalid("cron_expr required for CRON trigger")
+		}
+		if err := cron.Validate(job.CronExpr, job.Timezone); err != nil {
+			return invalid("%v", err)
+		}
+	case pb.TriggerType_TRIGGER_TYPE_FIXED_RATE:
+		if job.FixedRateSeconds <= 0 {
+			return invalid("fixed_rate_seconds must be > 0 for FIXED_RATE trigger")
+		}
+	case pb.TriggerType_TRIGGER_TYPE_ONE_TIME:
+		if job.OneTimeAt <= 0 {
+			return invalid("one_time_at required for ONE_TIME trigger")
+		}
+	}
+	return nil
+}
+
+// NextRuns 预览 job 在 after 之后的至多 n 次定时触发时间（CRON 按 timezone 计算，含夏令时切换；
+// ONE_TIME 为 one_time_at）。FIXED_RATE 以服务端上次触发为起点，无法在本地预测，与 API 等类型一样返回 nil。
+func NextRuns(job *pb.Job, after time.Time, n int) ([]time.Time, error) {
+	if err := ValidateJob(job); err != nil {
+		return nil, err
+	}
+	switch job.TriggerType {
+	case pb.TriggerType_TRIGGER_TYPE_CRON:
+		s, err := cron.ParseInZone(job.CronExpr, job.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		return s.NextN(after, n), nil
+	case pb.TriggerType_TRIGGER_TYPE_ONE_TIME:
+		if at := time.Unix(job.OneTimeAt, 0); n > 0 && at.After(after) {
+			return []time.Time{at}, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,278 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SyncAction SyncJobs 对单个 job 的动作。
+type SyncAction string
+
+const (
+	SyncCreate SyncAction = "create"
+	SyncUpdate SyncAction = "update"
+	SyncDelete SyncAction = "delete"
+	// SyncSkip prune 模式下不删除的 job（critical job 需在 UI 上经复核删除）
+	SyncSkip SyncAction = "skip"
+)
+
+// JobChange 同步计划中的一项。
+type JobChange struct {
+	Action  SyncAction `json:"action"`
+	JobName string     `json:"job_name"`
+	// Fields update 时发生变化的 proto 字段名
+	Fields []string `json:"fields,omitempty"`
+	// PendingApproval update 经双人复核提交，复核通过前服务端配置不变
+	PendingApproval bool   `json:"pending_approval,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+	// Err 执行该项时的错误；DryRun 时始终为 nil
+	Err error `json:"-"`
+	// NextRuns SyncOptions.Preview > 0 时，create 或触发配置有变化的 update 在同步后的定时触发时间预览
+	NextRuns []time.Time `json:"next_runs,omitempty"`
+
+	job *pb.Job // create / update 时提交给服务端的完整配置
+}
+
+// SyncPlan SyncJobs 计算出的差异；Changes 按 create → update → delete → skip、同类按 job 名排序。
+type SyncPlan struct {
+	Changes   []JobChange
+	Unchanged []string
+}
+
+// Empty 没有需要执行的变更。
+func (p *SyncPlan) Empty() bool {
+	for _, c := range p.Changes {
+		if c.Action != SyncSkip {
+			return false
+		}
+	}
+	return true
+}
+
+// String 以 "+ / ~ / - / !" 前缀逐行列出计划，供 dry-run 与 CI 日志阅读。
+func (p *SyncPlan) String() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		switch c.Action {
+		case SyncCreate:
+			fmt.Fprintf(&b, "+ create %s\n", c.JobName)
+		case SyncUpdate:
+			note := ""
+			if c.PendingApproval {
+				note = " (critical, requires approval)"
+			}
+			fmt.Fprintf(&b, "~ update %s%s: %s\n", c.JobName, note, strings.Join(c.Fields, ", "))
+		case SyncDelete:
+			fmt.Fprintf(&b, "- delete %s\n", c.JobName)
+		case SyncSkip:
+			fmt.Fprintf(&b, "! skip   %s: %s\n", c.JobName, c.Reason)
+		}
+		if len(c.NextRuns) > 0 {
+			next := make([]string, len(c.NextRuns))
+			for i, t := range c.NextRuns {
+				next[i] = t.Format(syncPreviewLayout)
+			}
+			fmt.Fprintf(&b, "  next: %s\n", strings.Join(next, ", "))
+		}
+		if c.Err != nil {
+			fmt.Fprintf(&b, "  error: %v\n", c.Err)
+		}
+	}
+	fmt.Fprintf(&b, "= %d unchanged\n", len(p.Unchanged))
+	return b.String()
+}
+
+// SyncOptions SyncJobs 的可选行为。
+type SyncOptions struct {
+	// Prune 删除服务端存在但 defs 中没有的本应用 job（critical job 只在计划中标记为 skip）。
+	// 注意：通过 EnsureJob 注册、未写入 defs 的 API job 也会被删除
+	Prune bool
+
+	// DryRun 只计算并打印计划，不做任何修改
+	DryRun bool
+
+	// Output DryRun 时计划的输出位置；默认 os.Stdout
+	Output io.Writer
+
+	// Preview 大于 0 时为 create 及触发配置有变化的 update 计算之后 Preview 次定时触发时间（JobChange.NextRuns），
+	// 用于应用前核对 cron_expr / timezone
+	Preview int
+}
+
+// syncPreviewLayout SyncPlan.String 中触发时间的格式，带时区偏移以便核对 timezone。
+const syncPreviewLayout = "2006-01-02 15:04:05 Z07:00"
+
+// jobScheduleFields 影响触发时间的字段；update 只有这些字段变化时才预览。
+var jobScheduleFields = map[string]bool{
+	"trigger_type": true, "cron_expr": true, "fixed_rate_seconds": true, "timezone": true,
+}
+
+// jobSyncFields SyncJobs 管理的 job 字段；其余字段（enabled / status / 审计字段等）始终保留服务端现值。
+var jobSyncFields = []protoreflect.Name{
+	"description", "trigger_type", "cron_expr", "fixed_rate_seconds", "timezone", "misfire_policy",
+	"timeout_seconds", "max_inflight", "retry_max", "retry_backoff",
+	"execute_mode", "shard_total", "priority", "critical",
+}
+
+// jobSyncAlwaysFields 零值也参与比对的字段：触发方式整体由定义决定，critical=false 表示取消关键标记。
+var jobSyncAlwaysFields = map[protoreflect.Name]bool{
+	"trigger_type": true, "cron_expr": true, "fixed_rate_seconds": true, "critical": true,
+}
+
+// SyncJobs 把声明式定义同步到 scheduler：与本应用现有 job（ListJobs）比对，
+// 新建缺失的 job、更新配置不一致的 job；critical job（服务端或定义任一侧）的更新以
+// require_approval 提交，进入双人复核。
+//
+// defs 全部校验通过后才会发起写操作。单项失败不影响其余项，错误记录在对应 JobChange.Err，
+// 并以 errors.Join 汇总返回；返回的 plan 在出错时也有效。
+func (a *AdminClient) SyncJobs(ctx context.Context, defs []JobDef, opts SyncOptions) (*SyncPlan, error) {
+	plan, err := a.planJobSync(ctx, defs, opts.Prune)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Preview > 0 {
+		plan.preview(time.Now(), opts.Preview)
+	}
+	if opts.DryRun {
+		out := opts.Output
+		if out == nil {
+			out = os.Stdout
+		}
+		_, err := io.WriteString(out, plan.String())
+		return plan, err
+	}
+
+	var errs []error
+	for i := range plan.Changes {
+		c := &plan.Changes[i]
+		switch c.Action {
+		case SyncCreate:
+			_, c.Err = a.CreateJob(ctx, c.job)
+		case SyncUpdate:
+			_, c.Err = a.UpdateJob(ctx, c.job, c.PendingApproval)
+		case SyncDelete:
+			c.Err = a.DeleteJob(ctx, c.JobName)
+		}
+		if c.Err != nil {
+			errs = append(errs, c.Err)
+		}
+	}
+	return plan, errors.Join(errs...)
+}
+
+// SyncJobs 复用本 Client 的连接执行 AdminClient.SyncJobs；必须在 Start 之后调用。
+func (c *Client) SyncJobs(ctx context.Context, defs []JobDef, opts SyncOptions) (*SyncPlan, error) {
+	admin, err := c.Admin()
+	if err != nil {
+		return nil, err
+	}
+	return admin.SyncJobs(ctx, defs, opts)
+}
+
+// planJobSync 拉取本应用全部 job 并计算差异。
+func (a *AdminClient) planJobSync(ctx context.Context, defs []JobDef, prune bool) (*SyncPlan, error) {
+	if err := validateJobDefs(defs); err != nil {
+		return nil, err
+	}
+	current := make(map[string]*pb.Job)
+	for job, err := range a.Jobs(ctx, JobFilter{AppName: a.cfg.AppName}) {
+		if err != nil {
+			return nil, err
+		}
+		current[job.JobName] = job
+	}
+
+	plan := &SyncPlan{}
+	wanted := make(map[string]bool, len(defs))
+	for i := range defs {
+		want, _ := defs[i].toJob(a.cfg.AppName) // validateJobDefs 已校验
+		wanted[want.JobName] = true
+		cur, ok := current[want.JobName]
+		if !ok {
+			plan.Changes = append(plan.Changes, JobChange{Action: SyncCreate, JobName: want.JobName, job: want})
+			continue
+		}
+		merged, fields := mergeJob(cur, want)
+		if len(fields) == 0 {
+			plan.Unchanged = append(plan.Unchanged, want.JobName)
+			continue
+		}
+		plan.Changes = append(plan.Changes, JobChange{
+			Action:          SyncUpdate,
+			JobName:         want.JobName,
+			Fields:          fields,
+			PendingApproval: cur.Critical || want.Critical,
+			job:             merged,
+		})
+	}
+	if prune {
+		for name, cur := range current {
+			switch {
+			case wanted[name]:
+			case cur.Critical:
+				plan.Changes = append(plan.Changes, JobChange{Action: SyncSkip, JobName: name, Reason: "critical job is not pruned"})
+			default:
+				plan.Changes = append(plan.Changes, JobChange{Action: SyncDelete, JobName: name})
+			}
+		}
+	}
+
+	order := map[SyncAction]int{SyncCreate: 0, SyncUpdate: 1, SyncDelete: 2, SyncSkip: 3}
+	sort.Slice(plan.Changes, func(i, j int) bool {
+		ci, cj := plan.Changes[i], plan.Changes[j]
+		if ci.Action != cj.Action {
+			return order[ci.Action] < order[cj.Action]
+		}
+		return ci.JobName < cj.JobName
+	})
+	sort.Strings(plan.Unchanged)
+	return plan, nil
+}
+
+// preview 为 create 及触发配置有变化的 update 填充 NextRuns。
+func (p *SyncPlan) preview(now time.Time, n int) {
+	for i := range p.Changes {
+		c := &p.Changes[i]
+		switch c.Action {
+		case SyncCreate:
+		case SyncUpdate:
+			if !slices.ContainsFunc(c.Fields, func(f string) bool { return jobScheduleFields[f] }) {
+				continue
+			}
+		default:
+			continue
+		}
+		c.NextRuns, _ = NextRuns(c.job, now, n) // validateJobDefs 已校验
+	}
+}
+
+// mergeJob 以 cur 为底套用 want 中受管理的字段，返回合并结果与变化的字段名。
+func mergeJob(cur, want *pb.Job) (*pb.Job, []string) {
+	merged := proto.Clone(cur).(*pb.Job)
+	mr, wr := merged.ProtoReflect(), want.ProtoReflect()
+	fds := mr.Descriptor().Fields()
+	var changed []string
+	for _, name := range jobSyncFields {
+		fd := fds.ByName(name)
+		if !wr.Has(fd) && !jobSyncAlwaysFields[name] {
+			continue
+		}
+		if mr.Get(fd).Equal(wr.Get(fd)) {
+			continue
+		}
+		mr.Set(fd, wr.Get(fd))
+		changed = append(changed, string(name))
+	}
+	return merged, changed
+}
@@ -0,0 +1,230 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+func TestParseJobDefs(t *testing.T) {
+	defs, err := ParseJobDefs([]byte(`
+jobs:
+  - name: report
+    cronExpr: "0 0 8 * * *"
+    timezone: Asia/Shanghai
+    timeout: 10m
+    retryBackoff: [30s, 2m]
+    executeMode: sharding
+    shardTotal: 4
+    priority: high
+    critical: true
+  - name: export
+`))
+	if err != nil || len(defs) != 2 {
+		t.Fatalf("defs=%v err=%v", defs, err)
+	}
+	job, err := defs[0].toJob("app")
+	if err != nil {
+		t.Fatalf("toJob: %v", err)
+	}
+	if job.TriggerType != pb.TriggerType_TRIGGER_TYPE_CRON || job.TimeoutSeconds != 600 || job.RetryBackoff != "[30,120]" ||
+		job.ExecuteMode != pb.ExecuteMode_EXECUTE_MODE_SHARDING || job.Priority != pb.Priority_PRIORITY_HIGH || !job.Critical {
+		t.Fatalf("unexpected job: %v", job)
+	}
+	if job, _ := defs[1].toJob("app"); job.TriggerType != pb.TriggerType_TRIGGER_TYPE_API {
+		t.Fatalf("job without schedule should be API, got %s", job.TriggerType)
+	}
+
+	tests := []struct {
+		name string
+		yaml string
+	}{
+		{name: "缺少 name", yaml: "jobs:\n  - cronExpr: '* * * * * *'\n"},
+		{name: "重名", yaml: "jobs:\n  - name: a\n  - name: a\n"},
+		{name: "cron 与 fixedRate 同时设置", yaml: "jobs:\n  - name: a\n    cronExpr: x\n    fixedRate: 1m\n"},
+		{name: "非法时长", yaml: "jobs:\n  - name: a\n    timeout: soon\n"},
+		{name: "分片缺少 shardTotal", yaml: "jobs:\n  - name: a\n    executeMode: sharding\n"},
+		{name: "未知优先级", yaml: "jobs:\n  - name: a\n    priority: urgent\n"},
+		{name: "未知字段", yaml: "jobs:\n  - name: a\n    cron: x\n"},
+		{name: "非法 cron", yaml: "jobs:\n  - name: a\n    cronExpr: '0 61 * * *'\n"},
+		{name: "非法时区", yaml: "jobs:\n  - name: a\n    cronExpr: '@daily'\n    timezone: Asia/Nowhere\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseJobDefs([]byte(tt.yaml)); !errors.Is(err, ErrInvalidJobDef) {
+				t.Fatalf("err=%v", err)
+			}
+		})
+	}
+}
+
+func TestAdminClient_SyncJobs(t *testing.T) {
+	srv, admin := newFakeAdmin(t)
+	ctx := waitCtx(t)
+	seed := func(job *pb.Job) {
+		job.AppName = srv.AppName()
+		if _, err := srv.CreateJob(context.Background(), &pb.CreateJobRequest{Job: job}); err != nil {
+			t.Fatalf("seed %s: %v", job.JobName, err)
+		}
+	}
+	seed(&pb.Job{JobName: "same", TriggerType: pb.TriggerType_TRIGGER_TYPE_API, TimeoutSeconds: 30})
+	seed(&pb.Job{JobName: "slow", TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON, CronExpr: "0 * * * * *", TimeoutSeconds: 60})
+	seed(&pb.Job{JobName: "billing", TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON, CronExpr: "0 0 * * * *", Critical: true})
+	seed(&pb.Job{JobName: "legacy", TriggerType: pb.TriggerType_TRIGGER_TYPE_API})
+	seed(&pb.Job{JobName: "legacy-critical", TriggerType: pb.TriggerType_TRIGGER_TYPE_API, Critical: true})
+
+	defs := []JobDef{
+		{Name: "same"}, // 未声明 timeout，保留服务端 30s
+		{Name: "slow", CronExpr: "0 * * * * *", Timeout: "5m"},
+		{Name: "billing", CronExpr: "0 30 * * * *", Critical: true},
+		{Name: "fresh", FixedRate: "10m"},
+	}
+
+	var out bytes.Buffer
+	plan, err := admin.SyncJobs(ctx, defs, SyncOptions{Prune: true, DryRun: true, Output: &out})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	want := "+ create fresh\n" +
+		"~ update billing (critical, requires approval): cron_expr\n" +
+		"~ update slow: timeout_seconds\n" +
+		"- delete legacy\n" +
+		"! skip   legacy-critical: critical job is not pruned\n" +
+		"= 1 unchanged\n"
+	if out.String() != want || plan.String() != want {
+		t.Fatalf("plan output:\n%s\nwant:\n%s", out.String(), want)
+	}
+	if len(srv.Jobs()) != 5 || len(srv.PendingChanges()) != 0 {
+		t.Fatal("dry run must not modify server")
+	}
+
+	if _, err := admin.SyncJobs(ctx, defs, SyncOptions{Prune: true}); err != nil {
+		t.Fatalf("SyncJobs: %v", err)
+	}
+	jobs := make(map[string]*pb.Job)
+	for _, j := range srv.Jobs() {
+		jobs[j.JobName] = j
+	}
+	if _, ok := jobs["legacy"]; ok {
+		t.Fatal("legacy should be pruned")
+	}
+	if _, ok := jobs["legacy-critical"]; !ok {
+		t.Fatal("critical job must not be pruned")
+	}
+	if j := jobs["fresh"]; j == nil || j.TriggerType != pb.TriggerType_TRIGGER_TYPE_FIXED_RATE || j.FixedRateSeconds != 600 {
+		t.Fatalf("fresh=%v", j)
+	}
+	if jobs["slow"].TimeoutSeconds != 300 || jobs["same"].TimeoutSeconds != 30 {
+		t.Fatalf("slow=%v same=%v", jobs["slow"], jobs["same"])
+	}
+	// critical job 复核前保持旧配置
+	changes := srv.PendingChanges()
+	if jobs["billing"].CronExpr != "0 0 * * * *" || len(changes) != 1 || changes[0].TargetId != "billing" {
+		t.Fatalf("billing=%v changes=%v", jobs["billing"], changes)
+	}
+	if err := admin.ApprovePendingChange(ctx, changes[0].Id, "reviewer"); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+
+	// 复核通过后再次同步无变更
+	plan, err = admin.SyncJobs(ctx, defs, SyncOptions{})
+	if err != nil || !plan.Empty() || len(plan.Unchanged) != 4 {
+		t.Fatalf("second sync plan=%v err=%v", plan, err)
+	}
+}
+
+func TestAdminClient_SyncJobsInvalidDefs(t *testing.T) {
+	srv, admin := newFakeAdmin(t)
+	_, err := admin.SyncJobs(waitCtx(t), []JobDef{{Name: "ok"}, {Name: "bad", Priority: "urgent"}}, SyncOptions{})
+	if !errors.Is(err, ErrInvalidJobDef) || !strings.Contains(err.Error(), "bad") {
+		t.Fatalf("err=%v", err)
+	}
+	if len(srv.Jobs()) != 0 {
+		t.Fatal("invalid defs must not create any job")
+	}
+}
+
+func TestAdminClient_SyncJobsPreview(t *testing.T) {
+	srv, admin := newFakeAdmin(t)
+	ctx := waitCtx(t)
+	for _, job := range []*pb.Job{
+		{JobName: "hourly", TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON, CronExpr: "0 0 * * * *"},
+		{JobName: "nightly", TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON, CronExpr: "0 0 2 * * *", Timezone: "UTC"},
+	} {
+		job.AppName = srv.AppName()
+		if _, err := srv.CreateJob(context.Background(), &pb.CreateJobRequest{Job: job}); err != nil {
+			t.Fatalf("seed %s: %v", job.JobName, err)
+		}
+	}
+	defs := []JobDef{
+		{Name: "report", CronExpr: "0 0 8 * * *", Timezone: "Asia/Shanghai"},
+		{Name: "hourly", CronExpr: "0 0 * * * *", Timeout: "5m"}, // 触发配置未变，不预览
+		{Name: "nightly", CronExpr: "0 0 2 * * *", Timezone: "Asia/Shanghai"},
+	}
+
+	var out bytes.Buffer
+	plan, err := admin.SyncJobs(ctx, defs, SyncOptions{DryRun: true, Output: &out, Preview: 2})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	hours := map[string]int{"report": 8, "nightly": 2}
+	for _, c := range plan.Changes {
+		h, ok := hours[c.JobName]
+		if !ok {
+			if len(c.NextRuns) != 0 {
+				t.Fatalf("%s: unexpected preview %v", c.JobName, c.NextRuns)
+			}
+			continue
+		}
+		if len(c.NextRuns) != 2 || c.NextRuns[1].Sub(c.NextRuns[0]) != 24*time.Hour {
+			t.Fatalf("%s: next runs %v", c.JobName, c.NextRuns)
+		}
+		if local := c.NextRuns[0].In(shanghai); local.Hour() != h || local.Minute() != 0 {
+			t.Fatalf("%s: first run %s", c.JobName, local)
+		}
+	}
+	if !strings.Contains(out.String(), "+ create report\n  next: ") || strings.Count(out.String(), "+08:00") != 4 {
+		t.Fatalf("plan output:\n%s", out.String())
+	}
+}
+
+func TestValidateJob(t *testing.T) {
+	cronJob := func(expr, tz string) *pb.Job {
+		return &pb.Job{JobName: "j", TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON, CronExpr: expr, Timezone: tz}
+	}
+	invalid := []*pb.Job{
+		nil,
+		{TriggerType: pb.TriggerType_TRIGGER_TYPE_API},
+		cronJob("", ""),
+		cronJob("0 0 8 * * * *", ""),
+		cronJob("0 0 8 * * *", "Asia/Nowhere"),
+		{JobName: "j", TriggerType: pb.TriggerType_TRIGGER_TYPE_FIXED_RATE},
+		{JobName: "j", TriggerType: pb.TriggerType_TRIGGER_TYPE_ONE_TIME},
+	}
+	for _, job := range invalid {
+		if err := ValidateJob(job); !errors.Is(err, ErrInvalidJobDef) {
+			t.Errorf("ValidateJob(%v) err=%v, want ErrInvalidJobDef", job, err)
+		}
+	}
+
+	now := time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC)
+	// 纽约 03-08 进入夏令时：同为当地 09:00，UTC 由 14:00 变为 13:00
+	runs, err := NextRuns(cronJob("0 9 * * *", "America/New_York"), now, 2)
+	if err != nil || len(runs) != 2 || runs[0].UTC().Hour() != 14 || runs[1].UTC().Hour() != 13 {
+		t.Fatalf("cron runs=%v err=%v", runs, err)
+	}
+	at := now.Add(time.Hour).Unix()
+	runs, err = NextRuns(&pb.Job{JobName: "j", TriggerType: pb.TriggerType_TRIGGER_TYPE_ONE_TIME, OneTimeAt: at}, now, 3)
+	if err != nil || len(runs) != 1 || runs[0].Unix() != at {
+		t.Fatalf("one time runs=%v err=%v", runs, err)
+	}
+	if runs, err = NextRuns(&pb.Job{JobName: "j", TriggerType: pb.TriggerType_TRIGGER_TYPE_FIXED_RATE, FixedRateSeconds: 60}, now, 3); err != nil || runs != nil {
+		t.Fatalf("fixed rate runs=%v err=%v", runs, err)
+	}
+}
@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	"github.com/sidchai/compkg/pkg/scheduler/internal/localsched"
+	"google.golang.org/grpc"
+)
+
+// localEndpoint LocalMode 下 Config.Endpoint 的默认值，仅用于日志；连接经 bufconn 直达进程内引擎。
+const localEndpoint = "local"
+
+// NewLocal 构造本地模式的 Client（等价于 Config.LocalMode=true 调用 New）：不连接 scheduler 服务，
+// Start 时在进程内启动调度引擎，RegisterHandler / SubmitTask / EnqueueTask / GetRun / CancelRun / WatchRun / Admin
+// 等 API 与远程模式一致，适合单机部署、本地开发与集成测试。
+//
+// 引擎按 EnsureJob / SyncJobs / AdminClient 登记的 job 配置自行触发（cron_expr + timezone、fixed_rate_seconds、
+// one_time_at，misfire_policy 支持 "skip"（默认）与 "fire_once"），FAILED / TIMEOUT 按 retry_max / retry_backoff 重试。
+// 配置 LocalHistoryFile 后 job 与 run 落盘，重启时恢复并重新排队未结束的 run。
+//
+// 本地与远程只差 Config.LocalMode 一个开关，业务代码无需改动：
+//
+//	cfg.LocalMode = os.Getenv("SCHED_LOCAL") == "1"
+//	c, err := scheduler.New(cfg)
+func NewLocal(cfg Config) (*Client, error) {
+	cfg.LocalMode = true
+	return New(cfg)
+}
+
+// dialScheduler 远程模式拨号 Config.Endpoint（多副本模式经 endpointRouting）；本地模式先启动进程内引擎再经 bufconn 拨号。
+func (c *Client) dialScheduler(ctx context.Context) (*grpc.ClientConn, error) {
+	if !c.cfg.LocalMode {
+		c.routing = newEndpointRouting(&c.cfg, c.clock)
+		return dial(ctx, &c.cfg, c.clock, c.routing)
+	}
+	engine, err := localsched.New(localsched.Options{
+		AppName:              c.cfg.AppName,
+		HistoryFile:          c.cfg.LocalHistoryFile,
+		MaxRuns:              c.cfg.LocalHistoryMaxRuns,
+		HeartbeatIntervalSec: int32(c.cfg.HeartbeatInterval / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	conn, err := dial(ctx, &c.cfg, c.clock, nil, grpc.WithContextDialer(engine.Dial))
+	if err != nil {
+		_ = engine.Close()
+		return nil, err
+	}
+	c.local = engine
+	return conn, nil
+}
+
+// closeLocal Stop 关闭连接后停止本地引擎（落盘历史文件）。
+func (c *Client) closeLocal() {
+	if c.local == nil {
+		return
+	}
+	if err := c.local.Close(); err != nil {
+		logger.Warnf("[scheduler-sdk] close local engine: %v", err)
+	}
+	c.local = nil
+}
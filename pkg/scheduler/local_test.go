@@ -0,0 +1,163 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// startLocalClient 以本地模式启动 Client；history 为空时不落盘。
+func startLocalClient(t *testing.T, history string, handlers map[string]HandlerFunc) *Client {
+	t.Helper()
+	c, err := NewLocal(Config{LocalHistoryFile: history})
+	if err != nil {
+		t.Fatalf("NewLocal: %v", err)
+	}
+	for name, h := range handlers {
+		c.RegisterHandler(name, h)
+	}
+	if err := c.Start(waitCtx(t)); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Stop(context.Background()) })
+	return c
+}
+
+func TestLocal_FixedRateJobFires(t *testing.T) {
+	var fired atomic.Int32
+	c := startLocalClient(t, "", map[string]HandlerFunc{
+		"tick": func(context.Context, *Job) (string, error) { fired.Add(1); return "", nil },
+	})
+	ctx := waitCtx(t)
+	admin, err := c.Admin()
+	if err != nil {
+		t.Fatalf("Admin: %v", err)
+	}
+	job, err := admin.CreateJob(ctx, &pb.Job{
+		JobName:          "tick",
+		TriggerType:      pb.TriggerType_TRIGGER_TYPE_FIXED_RATE,
+		FixedRateSeconds: 1,
+	})
+	if err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	if job.AppName != "local" || job.NextRunAt == 0 {
+		t.Fatalf("job=%v", job)
+	}
+	for fired.Load() < 2 {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("fired=%d within deadline", fired.Load())
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	if _, err := admin.CreateJob(ctx, &pb.Job{
+		JobName:     "bad",
+		TriggerType: pb.TriggerType_TRIGGER_TYPE_CRON,
+		CronExpr:    "61 * * * *",
+	}); err == nil {
+		t.Fatal("invalid cron must be rejected")
+	}
+}
+
+func TestLocal_RetryBackoffThenDead(t *testing.T) {
+	c := startLocalClient(t, "", map[string]HandlerFunc{
+		"flaky": func(_ context.Context, j *Job) (string, error) {
+			if j.RetryCount == 0 {
+				return "", errors.New("first attempt fails")
+			}
+			return "ok", nil
+		},
+		"broken": func(context.Context, *Job) (string, error) { return "", errors.New("always fails") },
+	})
+	ctx := waitCtx(t)
+	admin, _ := c.Admin()
+	for _, name := range []string{"flaky", "broken"} {
+		if _, err := admin.CreateJob(ctx, &pb.Job{JobName: name, RetryMax: 1, RetryBackoff: "[0.1]"}); err != nil {
+			t.Fatalf("CreateJob %s: %v", name, err)
+		}
+	}
+
+	runID, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "flaky"})
+	if err != nil {
+		t.Fatalf("SubmitTask: %v", err)
+	}
+	run, err := c.WaitRun(ctx, runID)
+	if err != nil || run.Status != pb.RunStatus_RUN_STATUS_SUCCESS || run.RetryCount != 1 || string(run.Output) != "ok" {
+		t.Fatalf("flaky run=%v err=%v", run, err)
+	}
+
+	runID, _, err = c.SubmitTask(ctx, SubmitOptions{JobName: "broken"})
+	if err != nil {
+		t.Fatalf("SubmitTask: %v", err)
+	}
+	run, err = c.WaitRun(ctx, runID)
+	if err != nil || run.Status != pb.RunStatus_RUN_STATUS_DEAD || run.RetryCount != 1 {
+		t.Fatalf("broken run=%v err=%v", run, err)
+	}
+}
+
+func TestLocal_CancelAndHistoryAcrossRestart(t *testing.T) {
+	history := filepath.Join(t.TempDir(), "history.jsonl")
+	handlers := map[string]HandlerFunc{
+		"echo": func(_ context.Context, j *Job) (string, error) { return string(j.Payload), nil },
+	}
+	c := startLocalClient(t, history, handlers)
+	ctx := waitCtx(t)
+
+	doneID, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", Payload: []byte("hi")})
+	if err != nil {
+		t.Fatalf("SubmitTask: %v", err)
+	}
+	if _, err := c.WaitRun(ctx, doneID); err != nil {
+		t.Fatalf("WaitRun: %v", err)
+	}
+	delayedID, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", Delay: time.Hour})
+	if err != nil {
+		t.Fatalf("SubmitTask delayed: %v", err)
+	}
+	canceledID, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo", Delay: time.Hour})
+	if err != nil {
+		t.Fatalf("SubmitTask delayed: %v", err)
+	}
+	if err := c.CancelRun(ctx, canceledID, "not needed"); err != nil {
+		t.Fatalf("CancelRun: %v", err)
+	}
+	if queued, err := c.EnqueueTask(ctx, SubmitOptions{JobName: "echo"}); err != nil || queued {
+		t.Fatalf("EnqueueTask queued=%v err=%v", queued, err)
+	}
+	if err := c.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	c = startLocalClient(t, history, handlers)
+	for id, want := range map[string]pb.RunStatus{
+		doneID:     pb.RunStatus_RUN_STATUS_SUCCESS,
+		delayedID:  pb.RunStatus_RUN_STATUS_PENDING,
+		canceledID: pb.RunStatus_RUN_STATUS_CANCELED,
+	} {
+		run, err := c.GetRun(ctx, id)
+		if err != nil || run.Status != want {
+			t.Fatalf("run %s after restart: %v err=%v, want %s", id, run, err, want)
+		}
+	}
+	run, _ := c.GetRun(ctx, doneID)
+	if string(run.Output) != "hi" {
+		t.Fatalf("output=%q", run.Output)
+	}
+	newID, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "echo"})
+	if err != nil || newID == doneID || newID == delayedID || newID == canceledID {
+		t.Fatalf("run id after restart=%q err=%v must not reuse old ids", newID, err)
+	}
+}
+
+func TestNewAdminClient_RejectsLocalMode(t *testing.T) {
+	if _, err := NewAdminClient(context.Background(), Config{LocalMode: true}); err == nil {
+		t.Fatal("NewAdminClient must reject LocalMode")
+	}
+}
@@ -0,0 +1,187 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/alert"
+	"github.com/sidchai/compkg/pkg/logger"
+	"github.com/sidchai/compkg/pkg/metrics"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// Middleware 包装 HandlerFunc，用于在每个 job 外围统一加日志 / 指标 / 追踪 / 校验 / 限流等横切逻辑。
+//
+// 执行顺序：Client.Use 注册的全局 middleware 在最外层（按注册顺序由外到内），
+// 其后是 RegisterHandler 传入的 per-job middleware，最内层才是业务 handler。
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Use 追加全局 middleware，作用于所有已注册和将注册的 handler（已注册的调用链随即重新组装）。必须在 Start 之前调用。
+func (c *Client) Use(mws ...Middleware) {
+	c.handlersMu.Lock()
+	defer c.handlersMu.Unlock()
+	for _, mw := range mws {
+		if mw == nil {
+			panic("scheduler: Use with nil middleware")
+		}
+		c.middlewares = append(c.middlewares, mw)
+	}
+	for jobName := range c.handlers {
+		c.buildChainLocked(jobName)
+	}
+}
+
+// chainMiddlewares 按 mws[0] 在最外层的顺序组装调用链。
+func chainMiddlewares(h HandlerFunc, mws ...Middleware) HandlerFunc {
+	for i := len(mws) - 1; i >= 0; i-- {
+		h = mws[i](h)
+	}
+	return h
+}
+
+// outcomeOf 返回 RunStatus 的短名（success/failed/timeout/...），用于日志字段与指标名。
+func outcomeOf(status pb.RunStatus) string {
+	switch status {
+	case pb.RunStatus_RUN_STATUS_SUCCESS:
+		return "success"
+	case pb.RunStatus_RUN_STATUS_TIMEOUT:
+		return "timeout"
+	case pb.RunStatus_RUN_STATUS_CANCELED:
+		return "canceled"
+	default:
+		return "failed"
+	}
+}
+
+// LoggingMiddleware 通过 logger.Ctx 为每次执行输出一条结构化日志：
+// job_name / run_id / biz_key / retry_count / elapsed_ms / outcome；失败时附 error，级别为 Warn。
+func LoggingMiddleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, job *Job) (string, error) {
+			start := time.Now()
+			output, err := next(ctx, job)
+			entry := logger.Ctx(ctx).
+				With("job_name", job.JobName).
+				With("run_id", job.RunID).
+				With("biz_key", job.BizKey).
+				With("retry_count", job.RetryCount).
+				With("elapsed_ms", time.Since(start).Milliseconds()).
+				With("outcome", outcomeOf(runStatusOf(err)))
+			if err != nil {
+				entry.WithErr(err).Warn("scheduler job finished with error")
+			} else {
+				entry.Info("scheduler job finished")
+			}
+			return output, err
+		}
+	}
+}
+
+// MetricsMiddleware 记录 job 执行耗时与结果的指标名；指标名固定，job / outcome 以 tag 区分。
+const (
+	metricJobDuration = "scheduler.job.duration_ms"
+	metricJobFailRate = "scheduler.job.fail_rate"
+	metricJobRuns     = "scheduler.job.runs"
+)
+
+// metricJobPanic PanicAlertMiddleware 告警的指标名；与上面一致固定，job / run_id 以 tag 区分。
+const metricJobPanic = "scheduler.job.panic"
+
+// jobMetrics 单个 job 的指标句柄；首次使用时创建，之后只做原子累加。
+type jobMetrics struct {
+	duration *metrics.HistogramMetric
+	failRate *metrics.RateMetric
+	outcomes map[string]*metrics.CounterMetric
+}
+
+// MetricsMiddleware 通过 pkg/metrics 记录每个 job 的执行耗时与结果：
+//
+//   - scheduler.job.duration_ms   Histogram，单位毫秒，tag job
+//   - scheduler.job.fail_rate     Rate，非 success 计为失败，tag job
+//   - scheduler.job.runs          Counter，tag job + outcome（success/failed/timeout/canceled）
+//
+// 启用 metrics.Config.EnablePrometheus 后以 job / outcome 为 label 导出；告警规则的 MetricName 填
+// metrics.SeriesKey 形式，如 "scheduler.job.fail_rate{job=export}"。
+func MetricsMiddleware() Middleware {
+	var (
+		mu    sync.Mutex
+		byJob = make(map[string]*jobMetrics)
+	)
+	get := func(jobName string) *jobMetrics {
+		mu.Lock()
+		defer mu.Unlock()
+		if m, ok := byJob[jobName]; ok {
+			return m
+		}
+		tags := map[string]string{"job": jobName}
+		m := &jobMetrics{
+			duration: metrics.HistogramWithTags(metricJobDuration, tags),
+			failRate: metrics.RateWithTags(metricJobFailRate, tags),
+			outcomes: make(map[string]*metrics.CounterMetric),
+		}
+		for _, outcome := range []string{"success", "failed", "timeout", "canceled"} {
+			m.outcomes[outcome] = metrics.CounterWithTags(metricJobRuns, map[string]string{"job": jobName, "outcome": outcome})
+		}
+		byJob[jobName] = m
+		return m
+	}
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, job *Job) (string, error) {
+			m := get(job.JobName)
+			start := time.Now()
+			output, err := next(ctx, job)
+			m.duration.Observe(float64(time.Since(start).Milliseconds()))
+			status := runStatusOf(err)
+			if status == pb.RunStatus_RUN_STATUS_SUCCESS {
+				m.failRate.RecordSuccess()
+			} else {
+				m.failRate.RecordFail()
+			}
+			m.outcomes[outcomeOf(status)].Inc()
+			return output, err
+		}
+	}
+}
+
+// PanicAlertMiddleware recover handler panic，异步通过 n 发送一条 P0 告警，并把 panic 转成 error 返回。
+//
+// 返回的 error 与 SDK 兜底 recover 的格式一致（含 panic 值 + stack），上报 RUN_STATUS_FAILED。
+// serviceName 写入 AlertEvent.ServiceName；n 为 nil 时只做 recover 不发告警。
+func PanicAlertMiddleware(serviceName string, n alert.Notifier) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, job *Job) (output string, err error) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					return
+				}
+				stack := debug.Stack()
+				output = ""
+				err = fmt.Errorf("handler panic: %v\n%s", r, stack)
+				if n == nil {
+					return
+				}
+				event := alert.AlertEvent{
+					ServiceName: serviceName,
+					MetricName:  metricJobPanic,
+					Level:       alert.LevelP0,
+					Title:       "scheduler job panic: " + job.JobName,
+					Message:     fmt.Sprintf("run_id=%s biz_key=%s panic=%v\n%s", job.RunID, job.BizKey, r, stack),
+					Value:       fmt.Sprint(r),
+					Tags:        map[string]string{"job": job.JobName, "run_id": job.RunID},
+					Timestamp:   time.Now(),
+				}
+				// 告警走 HTTP，不阻塞 JobResult 上报
+				go func() {
+					if sendErr := n.Send(event); sendErr != nil {
+						logger.Warnf("[scheduler-sdk] send panic alert job=%s run_id=%s err=%v", job.JobName, job.RunID, sendErr)
+					}
+				}()
+			}()
+			return next(ctx, job)
+		}
+	}
+}
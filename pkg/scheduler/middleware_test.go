@@ -0,0 +1,201 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/alert"
+	"github.com/sidchai/compkg/pkg/logger"
+	"github.com/sidchai/compkg/pkg/metrics"
+)
+
+func TestMiddleware_Order(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	var trail []string
+	mark := func(name string) Middleware {
+		return func(next HandlerFunc) HandlerFunc {
+			return func(ctx context.Context, job *Job) (string, error) {
+				trail = append(trail, name+">")
+				out, err := next(ctx, job)
+				trail = append(trail, "<"+name)
+				return out, err
+			}
+		}
+	}
+	c.Use(mark("g1"), mark("g2"))
+	c.RegisterHandler("job", func(_ context.Context, _ *Job) (string, error) {
+		trail = append(trail, "handler")
+		return "", nil
+	}, mark("j1"))
+	// Use 在 RegisterHandler 之后调用同样生效
+	c.Use(mark("g3"))
+
+	if _, err := c.lookupHandler("job")(context.Background(), &Job{JobName: "job"}); err != nil {
+		t.Fatalf("handler err: %v", err)
+	}
+	want := "g1> g2> g3> j1> handler <j1 <g3 <g2 <g1"
+	if got := strings.Join(trail, " "); got != want {
+		t.Fatalf("chain order\n got=%s\nwant=%s", got, want)
+	}
+}
+
+func TestMiddleware_ChainBuiltOnce(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	var built int
+	counting := func(next HandlerFunc) HandlerFunc {
+		built++
+		return next
+	}
+	c.RegisterHandler("job", func(_ context.Context, _ *Job) (string, error) { return "", nil }, Middleware(counting))
+	for range 3 {
+		if _, err := c.lookupHandler("job")(context.Background(), &Job{JobName: "job"}); err != nil {
+			t.Fatalf("handler err: %v", err)
+		}
+	}
+	if built != 1 {
+		t.Fatalf("per-job middleware built %d times, want once at RegisterHandler", built)
+	}
+	// Use 重新组装已注册的调用链
+	c.Use(func(next HandlerFunc) HandlerFunc { return next })
+	_, _ = c.lookupHandler("job")(context.Background(), &Job{JobName: "job"})
+	if built != 2 {
+		t.Fatalf("built=%d after Use, want 2", built)
+	}
+	if c.lookupHandler("missing") != nil {
+		t.Fatal("unregistered job must return nil")
+	}
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "job.log")
+	if err := logger.Bootstrap(logger.BootstrapOptions{ServiceName: "svc", File: logger.FileSinkOptions{Path: path}}); err != nil {
+		t.Fatalf("Bootstrap: %v", err)
+	}
+	t.Cleanup(func() { _ = logger.Shutdown(context.Background()) })
+
+	boom := errors.New("boom")
+	h := chainMiddlewares(func(_ context.Context, job *Job) (string, error) {
+		if job.RunID == "r2" {
+			return "", boom
+		}
+		return "ok", nil
+	}, LoggingMiddleware())
+	if out, err := h(context.Background(), &Job{JobName: "export", RunID: "r1", BizKey: "b1"}); out != "ok" || err != nil {
+		t.Fatalf("out=%q err=%v", out, err)
+	}
+	if _, err := h(context.Background(), &Job{JobName: "export", RunID: "r2", RetryCount: 2}); !errors.Is(err, boom) {
+		t.Fatalf("err=%v, want boom passed through", err)
+	}
+
+	_ = logger.Sync()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read log: %v", err)
+	}
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		m := map[string]any{}
+		if err := json.Unmarshal([]byte(line), &m); err != nil {
+			t.Fatalf("log line %q: %v", line, err)
+		}
+		lines = append(lines, m)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("log lines=%d, want 2:\n%s", len(lines), data)
+	}
+	ok, failed := lines[0], lines[1]
+	if ok["level"] != "info" || ok["job_name"] != "export" || ok["run_id"] != "r1" || ok["biz_key"] != "b1" || ok["outcome"] != "success" {
+		t.Fatalf("success line=%v", ok)
+	}
+	if _, has := ok["elapsed_ms"]; !has {
+		t.Fatalf("success line missing elapsed_ms: %v", ok)
+	}
+	if failed["level"] != "warn" || failed["outcome"] != "failed" || failed["error"] != "boom" || failed["retry_count"] != float64(2) {
+		t.Fatalf("failure line=%v", failed)
+	}
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	const job = "metrics-mw-job"
+	h := chainMiddlewares(func(ctx context.Context, _ *Job) (string, error) {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		return "ok", nil
+	}, MetricsMiddleware())
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, ctx := range []context.Context{context.Background(), context.Background(), canceled} {
+		_, _ = h(ctx, &Job{JobName: job})
+	}
+	// 其他 job 写入同名指标的不同序列，互不影响
+	_, _ = h(context.Background(), &Job{JobName: job + "-other"})
+
+	tags := map[string]string{"job": job}
+	if n := metrics.HistogramWithTags(metricJobDuration, tags).Snapshot().Count; n != 3 {
+		t.Fatalf("duration count=%d, want 3", n)
+	}
+	if r := metrics.RateWithTags(metricJobFailRate, tags).Snapshot(); r.Success != 2 || r.Fail != 1 {
+		t.Fatalf("fail_rate=%+v, want 2 success / 1 fail", r)
+	}
+	for outcome, want := range map[string]int64{"success": 2, "canceled": 1, "failed": 0} {
+		if got := metrics.CounterWithTags(metricJobRuns, map[string]string{"job": job, "outcome": outcome}).Value(); got != want {
+			t.Errorf("runs{outcome=%s}=%d, want %d", outcome, got, want)
+		}
+	}
+	if got := metrics.CounterWithTags(metricJobRuns, map[string]string{"job": job + "-other", "outcome": "success"}).Value(); got != 1 {
+		t.Fatalf("other job runs=%d, want 1", got)
+	}
+}
+
+type recordNotifier struct {
+	mu     sync.Mutex
+	events []alert.AlertEvent
+	sent   chan struct{}
+}
+
+func (n *recordNotifier) Send(e alert.AlertEvent) error {
+	n.mu.Lock()
+	n.events = append(n.events, e)
+	n.mu.Unlock()
+	n.sent <- struct{}{}
+	return nil
+}
+
+func TestPanicAlertMiddleware(t *testing.T) {
+	n := &recordNotifier{sent: make(chan struct{}, 1)}
+	h := chainMiddlewares(func(_ context.Context, _ *Job) (string, error) {
+		panic("boom")
+	}, PanicAlertMiddleware("svc", n))
+
+	out, err := h(context.Background(), &Job{JobName: "job", RunID: "r1"})
+	if err == nil || !strings.Contains(err.Error(), "handler panic: boom") {
+		t.Fatalf("expect panic converted to error, got out=%q err=%v", out, err)
+	}
+	select {
+	case <-n.sent:
+	case <-time.After(time.Second):
+		t.Fatal("alert not sent")
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.events) != 1 || n.events[0].Tags["run_id"] != "r1" || n.events[0].ServiceName != "svc" {
+		t.Fatalf("unexpected alert events: %+v", n.events)
+	}
+	if ev := n.events[0]; ev.MetricName != "scheduler.job.panic" || ev.Tags["job"] != "job" {
+		t.Fatalf("unexpected alert events: %+v", n.events)
+	}
+}
@@ -0,0 +1,204 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/sidchai/compkg/pkg/logger"
+	"github.com/sidchai/compkg/pkg/upload"
+)
+
+const (
+	// OffloadRefVersion 当前引用信封格式版本。
+	OffloadRefVersion = 1
+
+	defaultOffloadThreshold = 256 * 1024
+	defaultOffloadCatalogue = "scheduler-offload"
+)
+
+// offloadRefPrefix 引用信封的固定前缀（OffloadRef 首字段），用于快速判定，不必对每个 payload 做 JSON 解析。
+var offloadRefPrefix = []byte(`{"scheduler_offload":`)
+
+// ErrOffloadUnavailable payload / output 已转存到对象存储，但本 Client 未配置 Config.PayloadOffloadOss，无法取回。
+var ErrOffloadUnavailable = errors.New("scheduler: payload offloaded to object storage but Config.PayloadOffloadOss not set")
+
+// ErrOffloadCorrupt 取回的对象与引用信封中的大小 / sha256 不符。
+var ErrOffloadCorrupt = errors.New("scheduler: offloaded payload checksum mismatch")
+
+// OffloadRef 大 payload / output 转存到对象存储后代替原内容发送的引用信封（JSON）：
+//
+//	{"scheduler_offload":1,"url":"https://bucket.oss/scheduler-offload/app/payload-<uuid>.bin","size":5242880,"sha256":"<hex>"}
+//
+// scheduler_offload 为格式版本，始终是第一个字段；非 Go 消费方按前缀识别后下载 url，并校验 size / sha256。
+type OffloadRef struct {
+	Version int    `json:"scheduler_offload"`
+	URL     string `json:"url"`
+	Size    int64  `json:"size"`
+	SHA256  string `json:"sha256"`
+}
+
+// ParseOffloadRef 判断 data 是否为引用信封；不是（或版本不支持）时返回 false。
+func ParseOffloadRef(data []byte) (*OffloadRef, bool) {
+	if !bytes.HasPrefix(data, offloadRefPrefix) {
+		return nil, false
+	}
+	var ref OffloadRef
+	if err := json.Unmarshal(data, &ref); err != nil || ref.Version != OffloadRefVersion || ref.URL == "" {
+		return nil, false
+	}
+	return &ref, true
+}
+
+// payloadOffloader 经 pkg/upload 的 Oss 转存大 payload / output。nil 表示未启用：不转存，遇到引用返回 ErrOffloadUnavailable。
+//
+// upload.Oss 实现带有目录、ETag 等可变状态（上传时还会改写目录，如 Minio 按日期追加子目录），不保证并发安全：
+// 所有调用经 mu 串行，每次上传前重设目录。
+type payloadOffloader struct {
+	threshold int
+	catalogue string
+	dir       string
+
+	mu  sync.Mutex
+	oss upload.Oss
+}
+
+func newPayloadOffloader(cfg *Config) *payloadOffloader {
+	if cfg.PayloadOffloadOss == nil {
+		return nil
+	}
+	return &payloadOffloader{
+		threshold: cfg.PayloadOffloadThreshold,
+		catalogue: cfg.PayloadOffloadCatalogue + "/" + cfg.AppName,
+		dir:       cfg.PayloadOffloadDir,
+		oss:       cfg.PayloadOffloadOss,
+	}
+}
+
+// offload data 超过阈值时上传并返回引用信封，否则（或已是引用信封）原样返回；kind 为对象名前缀（payload / output）。
+func (o *payloadOffloader) offload(kind string, data []byte) ([]byte, error) {
+	if o == nil || len(data) <= o.threshold {
+		return data, nil
+	}
+	if _, ok := ParseOffloadRef(data); ok {
+		return data, nil
+	}
+	sum := sha256.Sum256(data)
+	name := kind + "-" + uuid.NewString() + ".bin"
+	o.mu.Lock()
+	o.oss.SetCatalogue(o.catalogue)
+	url, err := o.oss.UploadFileIo(name, bytes.NewReader(data))
+	o.mu.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("scheduler: offload %s (%d bytes): %w", kind, len(data), err)
+	}
+	return json.Marshal(OffloadRef{Version: OffloadRefVersion, URL: url, Size: int64(len(data)), SHA256: hex.EncodeToString(sum[:])})
+}
+
+// fetch 下载 ref 指向的对象并校验；下载的临时文件读入内存后即删除。
+func (o *payloadOffloader) fetch(ref *OffloadRef) ([]byte, error) {
+	if o == nil {
+		return nil, ErrOffloadUnavailable
+	}
+	if err := os.MkdirAll(o.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("scheduler: create offload dir: %w", err)
+	}
+	// Oss.Download 按 dataFolder+fileName 拼接路径，目录需以分隔符结尾
+	name := uuid.NewString() + ".bin"
+	folder := o.dir + string(filepath.Separator)
+	o.mu.Lock()
+	err := o.oss.Download(ref.URL, name, folder)
+	o.mu.Unlock()
+	path := filepath.Join(o.dir, name)
+	defer os.Remove(path)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler: fetch offloaded payload %s: %w", ref.URL, err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler: fetch offloaded payload %s: %w", ref.URL, err)
+	}
+	if sum := sha256.Sum256(data); int64(len(data)) != ref.Size || hex.EncodeToString(sum[:]) != ref.SHA256 {
+		return nil, fmt.Errorf("%w: %s", ErrOffloadCorrupt, ref.URL)
+	}
+	return data, nil
+}
+
+// remove 删除 ref 指向的对象，失败只记 warn（对象存储生命周期规则兜底）。
+func (o *payloadOffloader) remove(ref *OffloadRef) {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	err := o.oss.Delete(ref.URL)
+	o.mu.Unlock()
+	if err != nil {
+		logger.Warnf("[scheduler-sdk] delete offloaded payload %s err=%v", ref.URL, err)
+	}
+}
+
+// removeAll 逐个删除 refs 指向的对象；结果确认送达后删除已结束 run 的 payload 时调用。
+func (o *payloadOffloader) removeAll(refs []*OffloadRef) {
+	for _, ref := range refs {
+		o.remove(ref)
+	}
+}
+
+// lazyPayload handler 首次调用 Job.LoadPayload 时才下载转存的 payload，同一次执行内只下载一次。
+type lazyPayload struct {
+	ref *OffloadRef
+	o   *payloadOffloader
+
+	once sync.Once
+	data []byte
+	err  error
+}
+
+// LoadPayload 返回业务负载：payload 经 Config.PayloadOffloadOss 转存时（Job.Payload 为 OffloadRef 引用信封）
+// 首次调用下载并校验，之后返回缓存；否则直接返回 Job.Payload。RegisterTypedHandler 已自动调用。
+func (j *Job) LoadPayload(ctx context.Context) ([]byte, error) {
+	if j.lazy == nil {
+		return j.Payload, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	j.lazy.once.Do(func() { j.lazy.data, j.lazy.err = j.lazy.o.fetch(j.lazy.ref) })
+	return j.lazy.data, j.lazy.err
+}
+
+// Offloaded Job.Payload 是否为转存到对象存储的引用信封（需经 LoadPayload 取回）。
+func (j *Job) Offloaded() bool {
+	return j.lazy != nil
+}
+
+// ResolvePayload 把引用信封解析为原内容（如 Run.Output、他处转发的 payload）；不是引用信封时原样返回。
+// SubmitAndWait 已自动调用。
+func (c *Client) ResolvePayload(ctx context.Context, data []byte) ([]byte, error) {
+	ref, ok := ParseOffloadRef(data)
+	if !ok {
+		return data, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.offload.fetch(ref)
+}
+
+// offloadSubmit 按阈值转存 opts.Payload。
+func (c *Client) offloadSubmit(opts *SubmitOptions) error {
+	payload, err := c.offload.offload("payload", opts.Payload)
+	if err != nil {
+		return err
+	}
+	opts.Payload = payload
+	return nil
+}
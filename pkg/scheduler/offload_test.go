@@ -0,0 +1,268 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	"github.com/sidchai/compkg/pkg/upload"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// fakeOss 内存对象存储，只实现转存用到的 SetCatalogue / UploadFileIo / Download / Delete。
+// catalogue 与真实实现一样不加锁；isTime 模拟 Minio 上传时把日期子目录追加进 catalogue。
+type fakeOss struct {
+	upload.Oss
+
+	catalogue string
+	isTime    bool
+
+	mu      sync.Mutex
+	objects map[string][]byte
+	deleted []string
+}
+
+func newFakeOss() *fakeOss { return &fakeOss{objects: map[string][]byte{}} }
+
+func (f *fakeOss) SetCatalogue(catalogue string) { f.catalogue = catalogue }
+
+func (f *fakeOss) UploadFileIo(fileName string, content io.Reader) (string, error) {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+	if f.isTime {
+		f.catalogue += "/2026/10/18"
+	}
+	url := "https://oss.test/" + f.catalogue + "/" + fileName
+	f.mu.Lock()
+	f.objects[url] = data
+	f.mu.Unlock()
+	return url, nil
+}
+
+func (f *fakeOss) Download(fileUrl, fileName, dataFolder string) error {
+	f.mu.Lock()
+	data, ok := f.objects[fileUrl]
+	f.mu.Unlock()
+	if !ok {
+		return errors.New("object not found")
+	}
+	return os.WriteFile(dataFolder+fileName, data, 0o600)
+}
+
+func (f *fakeOss) Delete(path string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.objects, path)
+	f.deleted = append(f.deleted, path)
+	return nil
+}
+
+func (f *fakeOss) deletedURLs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.deleted...)
+}
+
+func (f *fakeOss) corrupt(url string) {
+	f.mu.Lock()
+	f.objects[url] = append([]byte(nil), f.objects[url][1:]...)
+	f.mu.Unlock()
+}
+
+func TestIntegration_PayloadOffload(t *testing.T) {
+	oss := newFakeOss()
+	dir := t.TempDir()
+	big := bytes.Repeat([]byte("x"), 2048)
+	var got []byte
+	srv, c := startFakeClient(t, schedulertest.Options{}, func(cfg *Config) {
+		cfg.PayloadOffloadOss = oss
+		cfg.PayloadOffloadThreshold = 1024
+		cfg.PayloadOffloadDir = dir
+		cfg.PayloadOffloadDeleteOnComplete = true
+	}, map[string]HandlerFunc{
+		"report": func(ctx context.Context, job *Job) (string, error) {
+			if !job.Offloaded() {
+				return "", errors.New("payload not offloaded")
+			}
+			data, err := job.LoadPayload(ctx)
+			if err != nil {
+				return "", err
+			}
+			got = data
+			return strings.Repeat("y", 4096), nil
+		},
+		"flaky": func(context.Context, *Job) (string, error) { return "", errors.New("boom") },
+	})
+	ctx := waitCtx(t)
+
+	runID, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "report", Payload: big})
+	if err != nil {
+		t.Fatalf("SubmitTask: %v", err)
+	}
+	var payload []byte
+	for _, r := range srv.Runs() {
+		if r.RunId == runID {
+			payload = r.Payload
+		}
+	}
+	ref, ok := ParseOffloadRef(payload)
+	if !ok || ref.Size != int64(len(big)) || !strings.Contains(ref.URL, "scheduler-offload/"+c.cfg.AppName+"/payload-") {
+		t.Fatalf("submitted payload is not a reference: %s", payload)
+	}
+
+	if err := srv.Dispatch(&pb.Dispatch{RunId: runID, JobName: "report", Payload: payload}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	res, err := srv.WaitResult(ctx, runID)
+	if err != nil {
+		t.Fatalf("WaitResult: %v", err)
+	}
+	if res.Status != pb.RunStatus_RUN_STATUS_SUCCESS || !bytes.Equal(got, big) {
+		t.Fatalf("status=%v err=%s payload len=%d", res.Status, res.Error, len(got))
+	}
+	out, err := c.ResolvePayload(ctx, []byte(res.Output))
+	if err != nil || string(out) != strings.Repeat("y", 4096) {
+		t.Fatalf("ResolvePayload len=%d err=%v", len(out), err)
+	}
+	// 结果确认送达（session 存活超过确认窗口）之前保留 payload：此时断线重派发的 run 仍能取回
+	if deleted := oss.deletedURLs(); len(deleted) != 0 {
+		t.Fatalf("payload deleted before result confirmed: %v", deleted)
+	}
+	waitDeleted := func(want ...string) {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for c.PendingResults() > 0 || len(oss.deletedURLs()) < len(want) {
+			if time.Now().After(deadline) {
+				t.Fatalf("pending=%d deleted=%v, want %v", c.PendingResults(), oss.deletedURLs(), want)
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		if got := oss.deletedURLs(); !slices.Equal(got, want) {
+			t.Fatalf("deleted=%v, want %v", got, want)
+		}
+	}
+	waitDeleted(ref.URL)
+	ctx = waitCtx(t)
+
+	// 失败但仍会重试的 run 保留 payload，最后一次重试失败（服务端转 DEAD）后删除
+	var retried, exhausted *OffloadRef
+	for i, retry := range []int32{0, 2} {
+		env, err := c.offload.offload("payload", big)
+		if err != nil {
+			t.Fatalf("offload: %v", err)
+		}
+		runID := fmt.Sprintf("flaky-%d", i)
+		if err := srv.Dispatch(&pb.Dispatch{RunId: runID, JobName: "flaky", Payload: env, RetryCount: retry, RetryMax: 2}); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+		if res, err := srv.WaitResult(ctx, runID); err != nil || res.Status != pb.RunStatus_RUN_STATUS_FAILED {
+			t.Fatalf("%s res=%v err=%v", runID, res, err)
+		}
+		retried, exhausted = exhausted, mustParseRef(t, env)
+	}
+	waitDeleted(ref.URL, exhausted.URL)
+	ctx = waitCtx(t)
+	if slices.Contains(oss.deletedURLs(), retried.URL) {
+		t.Fatalf("payload of a run that will be retried was deleted: %s", retried.URL)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("download temp files left: %d", len(entries))
+	}
+
+	// 小于阈值原样发送
+	small, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "report", Payload: []byte("tiny")})
+	if err != nil {
+		t.Fatalf("SubmitTask small: %v", err)
+	}
+	for _, r := range srv.Runs() {
+		if r.RunId == small && string(r.Payload) != "tiny" {
+			t.Fatalf("small payload rewritten: %s", r.Payload)
+		}
+	}
+}
+
+func TestPayloadOffloader_Errors(t *testing.T) {
+	ctx := context.Background()
+	oss := newFakeOss()
+	cfg := Config{PayloadOffloadOss: oss, AppName: "app"}
+	cfg.applyDefaults()
+	cfg.PayloadOffloadThreshold, cfg.PayloadOffloadDir = 4, t.TempDir()
+	o := newPayloadOffloader(&cfg)
+
+	env, err := o.offload("payload", []byte("hello world"))
+	if err != nil {
+		t.Fatalf("offload: %v", err)
+	}
+	ref, ok := ParseOffloadRef(env)
+	if !ok {
+		t.Fatalf("not a reference: %s", env)
+	}
+	if again, err := o.offload("payload", env); err != nil || !bytes.Equal(again, env) {
+		t.Fatalf("reference re-offloaded: %s err=%v", again, err)
+	}
+
+	// 上传会改写实例目录（Minio IsTime）且目录被业务改动过：每次上传前重设，并发上传串行执行（-race 校验）
+	oss.isTime = true
+	oss.SetCatalogue("audio")
+	var wg sync.WaitGroup
+	urls := make([]string, 8)
+	for i := range urls {
+		wg.Go(func() {
+			env, err := o.offload("payload", []byte("hello world"))
+			if err != nil {
+				t.Errorf("offload: %v", err)
+				return
+			}
+			urls[i] = mustParseRef(t, env).URL
+		})
+	}
+	wg.Wait()
+	for _, url := range urls {
+		if !strings.HasPrefix(url, "https://oss.test/"+o.catalogue+"/2026/10/18/payload-") {
+			t.Fatalf("object uploaded outside the offload catalogue: %s", url)
+		}
+	}
+	oss.isTime = false
+
+	// 未配置 Oss 的 Client 收到引用
+	c := &Client{}
+	if _, err := c.ResolvePayload(ctx, env); !errors.Is(err, ErrOffloadUnavailable) {
+		t.Fatalf("err=%v, want ErrOffloadUnavailable", err)
+	}
+	job := &Job{Payload: env, lazy: &lazyPayload{ref: ref}}
+	if _, err := job.LoadPayload(ctx); !errors.Is(err, ErrOffloadUnavailable) {
+		t.Fatalf("LoadPayload err=%v", err)
+	}
+
+	// 对象被篡改 / 截断
+	oss.corrupt(ref.URL)
+	if _, err := o.fetch(ref); !errors.Is(err, ErrOffloadCorrupt) {
+		t.Fatalf("err=%v, want ErrOffloadCorrupt", err)
+	}
+
+	for _, data := range []string{`{"scheduler_offload":2,"url":"u"}`, `{"url":"u"}`, `{"scheduler_offload":1}`, "plain"} {
+		if _, ok := ParseOffloadRef([]byte(data)); ok {
+			t.Fatalf("%s parsed as reference", data)
+		}
+	}
+}
+
+func mustParseRef(t *testing.T, env []byte) *OffloadRef {
+	t.Helper()
+	ref, ok := ParseOffloadRef(env)
+	if !ok {
+		t.Fatalf("not a reference: %s", env)
+	}
+	return ref
+}
@@ -0,0 +1,230 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// ErrNotInHandler ReportProgress / ReportCheckpoint 的 ctx 不是 SDK 传给 handler 的 ctx（或其派生）。
+var ErrNotInHandler = errors.New("scheduler: progress reported outside handler")
+
+// progressCtxKey handler ctx 中 *runProgress 的 key。
+type progressCtxKey struct{}
+
+// runProgress 单个 run 的进度上报句柄，随 handler ctx 传递。
+type runProgress struct {
+	runID string
+	box   *progressBox
+	lease *leaseCtx // Dispatch 未设置 timeout_sec 时为 nil
+}
+
+// ReportProgress 在 handler 内上报执行进度：pct 取值 0-100（越界截断），msg 展示在 UI 上。
+//
+// 每次上报同时续期超时租约：服务端与 SDK 本地都从此刻重新计 Job.TimeoutSec，
+// 长任务只要持续上报就不会被判定超时；停止上报（如卡死）仍按 timeout 兜底。
+// 上报异步合并发送（同一 run 只保留最新一条），不会阻塞 handler；断线期间的进度在重连后补发。
+//
+//	for i, row := range rows {
+//	    ...
+//	    if i%1000 == 0 {
+//	        _ = scheduler.ReportProgress(ctx, i*100/len(rows), fmt.Sprintf("%d/%d", i, len(rows)))
+//	    }
+//	}
+func ReportProgress(ctx context.Context, pct int, msg string) error {
+	return ReportCheckpoint(ctx, pct, msg, nil)
+}
+
+// ReportCheckpoint 同 ReportProgress，并保存断点数据 checkpoint：run 失败重试时服务端经
+// Job.Checkpoint 回传最近一次非空 checkpoint，handler 可据此续跑而不是从头开始。
+// checkpoint 为空表示沿用上一次；大小受服务端 payload 上限约束。
+func ReportCheckpoint(ctx context.Context, pct int, msg string, checkpoint []byte) error {
+	rp, _ := ctx.Value(progressCtxKey{}).(*runProgress)
+	if rp == nil {
+		return ErrNotInHandler
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	pct = max(0, min(pct, 100))
+	if rp.lease != nil {
+		rp.lease.extend()
+	}
+	rp.box.put(&pb.JobProgress{
+		RunId:      rp.runID,
+		Percent:    int32(pct),
+		Message:    msg,
+		Checkpoint: checkpoint,
+		Ts:         time.Now().UnixMilli(),
+	})
+	return nil
+}
+
+// progressBox 待发送的 JobProgress，按 run_id 合并：进度只关心最新值，高频上报不会堆积。
+//
+// 与 resultOutbox 不同，进度是尽力而为：只保存在内存、发送后即移除；
+// sender 总是先 flush 进度再 flush 结果，保证同一 run 的最后一次进度（含 checkpoint）先于结果到达。
+type progressBox struct {
+	mu      sync.Mutex
+	order   []string
+	pending map[string]*pb.JobProgress
+
+	notify chan struct{} // put 事件信号，唤醒当前 session 的 sender
+}
+
+func newProgressBox() *progressBox {
+	return &progressBox{pending: make(map[string]*pb.JobProgress), notify: make(chan struct{}, 1)}
+}
+
+// put 登记 p；同 run 已有未发送的进度时覆盖，但保留其 checkpoint（p 未携带时）。
+func (b *progressBox) put(p *pb.JobProgress) {
+	b.mu.Lock()
+	if old, ok := b.pending[p.RunId]; ok {
+		if len(p.Checkpoint) == 0 {
+			p.Checkpoint = old.Checkpoint
+		}
+	} else {
+		b.order = append(b.order, p.RunId)
+	}
+	b.pending[p.RunId] = p
+	b.mu.Unlock()
+
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
+}
+
+// take 按首次上报顺序取出并清空全部待发送进度。
+func (b *progressBox) take() []*pb.JobProgress {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := make([]*pb.JobProgress, 0, len(b.order))
+	for _, runID := range b.order {
+		out = append(out, b.pending[runID])
+	}
+	b.order = b.order[:0]
+	clear(b.pending)
+	return out
+}
+
+func (b *progressBox) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.order)
+}
+
+// restore 把发送失败的进度放回（已有更新的进度时丢弃旧值，checkpoint 同样保留）。
+func (b *progressBox) restore(ps []*pb.JobProgress) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, p := range ps {
+		if cur, ok := b.pending[p.RunId]; ok {
+			if len(cur.Checkpoint) == 0 {
+				cur.Checkpoint = p.Checkpoint
+			}
+			continue
+		}
+		b.order = append(b.order, p.RunId)
+		b.pending[p.RunId] = p
+	}
+}
+
+// flushProgress 把待发送进度写入当前 stream；写失败时放回未发送部分并返回 err 结束本 session。
+func (c *Client) flushProgress(send func(*pb.WorkerMessage) error) error {
+	ps := c.progressBox.take()
+	for i, p := range ps {
+		if err := send(&pb.WorkerMessage{Payload: &pb.WorkerMessage_Progress{Progress: p}}); err != nil {
+			c.progressBox.restore(ps[i:])
+			return err
+		}
+	}
+	return nil
+}
+
+// leaseCtx 可续期的超时 ctx：到期后 Done 关闭、Err 返回 context.DeadlineExceeded，与 context.WithTimeout 一致；
+// extend 把截止时间推迟到 now+timeout。
+//
+// 不内嵌标准库 cancelCtx：派生 ctx 经 Done 监听本 ctx，取消时 Err 同样为 DeadlineExceeded，
+// handler 返回派生 ctx 的错误时仍上报 RUN_STATUS_TIMEOUT。
+type leaseCtx struct {
+	parent  context.Context
+	timeout time.Duration
+	done    chan struct{}
+	stop    func() bool // 解除对 parent 的监听
+
+	mu       sync.Mutex
+	deadline time.Time
+	timer    *time.Timer
+	err      error
+}
+
+// withLease 派生超时为 timeout 的 leaseCtx；返回的 cancel 与 context.WithTimeout 的用法一致。
+func withLease(parent context.Context, timeout time.Duration) (*leaseCtx, context.CancelFunc) {
+	l := &leaseCtx{
+		parent:   parent,
+		timeout:  timeout,
+		done:     make(chan struct{}),
+		deadline: time.Now().Add(timeout),
+	}
+	// 持锁创建：timer / parent 回调可能立即触发，须等两者都赋值后才能进入 finish
+	l.mu.Lock()
+	l.timer = time.AfterFunc(timeout, l.onTimer)
+	l.stop = context.AfterFunc(parent, func() { l.finish(parent.Err()) })
+	l.mu.Unlock()
+	return l, func() { l.finish(context.Canceled) }
+}
+
+func (l *leaseCtx) Deadline() (time.Time, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.deadline, true
+}
+
+func (l *leaseCtx) Done() <-chan struct{} { return l.done }
+
+func (l *leaseCtx) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+func (l *leaseCtx) Value(key any) any { return l.parent.Value(key) }
+
+// extend 续期租约；已结束的 ctx 不受影响。
+func (l *leaseCtx) extend() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return
+	}
+	l.deadline = time.Now().Add(l.timeout)
+	l.timer.Reset(l.timeout)
+}
+
+// onTimer 定时器触发；与 extend 并发时以最新 deadline 为准。
+func (l *leaseCtx) onTimer() {
+	l.mu.Lock()
+	if remaining := time.Until(l.deadline); remaining > 0 && l.err == nil {
+		l.timer.Reset(remaining)
+		l.mu.Unlock()
+		return
+	}
+	l.mu.Unlock()
+	l.finish(context.DeadlineExceeded)
+}
+
+func (l *leaseCtx) finish(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.err != nil {
+		return
+	}
+	l.err = err
+	l.timer.Stop()
+	l.stop()
+	close(l.done)
+}
@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+func TestLeaseCtx_ExtendAndExpire(t *testing.T) {
+	lease, cancel := withLease(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	child, childCancel := context.WithCancel(lease)
+	defer childCancel()
+
+	for range 4 {
+		time.Sleep(75 * time.Millisecond)
+		lease.extend()
+	}
+	if err := lease.Err(); err != nil {
+		t.Fatalf("extended lease expired early: %v", err)
+	}
+	select {
+	case <-child.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("lease never expired")
+	}
+	if !errors.Is(lease.Err(), context.DeadlineExceeded) || !errors.Is(child.Err(), context.DeadlineExceeded) {
+		t.Fatalf("lease err=%v child err=%v, want DeadlineExceeded", lease.Err(), child.Err())
+	}
+
+	parent, parentCancel := context.WithCancel(context.Background())
+	lease2, cancel2 := withLease(parent, time.Hour)
+	defer cancel2()
+	parentCancel()
+	<-lease2.Done()
+	if !errors.Is(lease2.Err(), context.Canceled) {
+		t.Fatalf("parent cancel err=%v", lease2.Err())
+	}
+}
+
+func TestProgressBox_CoalescesKeepingCheckpoint(t *testing.T) {
+	b := newProgressBox()
+	b.put(&pb.JobProgress{RunId: "r1", Percent: 10, Checkpoint: []byte("cp1")})
+	b.put(&pb.JobProgress{RunId: "r2", Percent: 5})
+	b.put(&pb.JobProgress{RunId: "r1", Percent: 20})
+
+	got := b.take()
+	if len(got) != 2 || got[0].RunId != "r1" || got[0].Percent != 20 || string(got[0].Checkpoint) != "cp1" || got[1].RunId != "r2" {
+		t.Fatalf("unexpected take: %v", got)
+	}
+	if len(b.take()) != 0 {
+		t.Fatal("take must drain the box")
+	}
+
+	b.put(&pb.JobProgress{RunId: "r1", Percent: 30})
+	b.restore(got)
+	again := b.take()
+	if len(again) != 2 || again[0].Percent != 30 || string(again[0].Checkpoint) != "cp1" {
+		t.Fatalf("restore must keep newer progress and older checkpoint: %v", again)
+	}
+}
+
+func TestReportProgress_OutsideHandler(t *testing.T) {
+	if err := ReportProgress(context.Background(), 10, "x"); !errors.Is(err, ErrNotInHandler) {
+		t.Fatalf("err=%v, want ErrNotInHandler", err)
+	}
+}
+
+func TestIntegration_ProgressExtendsLeaseAndResumesFromCheckpoint(t *testing.T) {
+	srv, _ := startFakeClient(t, schedulertest.Options{}, nil, map[string]HandlerFunc{
+		"export": func(ctx context.Context, job *Job) (string, error) {
+			if len(job.Checkpoint) == 0 {
+				if err := ReportCheckpoint(ctx, 150, "half way", []byte("offset=500")); err != nil {
+					return "", err
+				}
+				return "", errors.New("db connection reset")
+			}
+			// 总耗时超过 timeout_sec=1，持续上报进度续期
+			for i := 1; i <= 6; i++ {
+				time.Sleep(250 * time.Millisecond)
+				if err := ReportProgress(ctx, 50+i*5, "resumed"); err != nil {
+					return "", err
+				}
+			}
+			return "resumed from " + string(job.Checkpoint), ctx.Err()
+		},
+	})
+	ctx := waitCtx(t)
+
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "export", TimeoutSec: 1}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	res, err := srv.WaitResult(ctx, "r1")
+	if err != nil || res.Status != pb.RunStatus_RUN_STATUS_FAILED {
+		t.Fatalf("first attempt res=%v err=%v", res, err)
+	}
+	p, err := srv.WaitProgress(ctx, "r1", 100)
+	if err != nil || p.Message != "half way" || string(p.Checkpoint) != "offset=500" {
+		t.Fatalf("progress=%v err=%v", p, err)
+	}
+
+	// 同一 run 重新派发，fake 回传 checkpoint
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "export", TimeoutSec: 1, RetryCount: 1}); err != nil {
+		t.Fatalf("Dispatch retry: %v", err)
+	}
+	for len(srv.Results()) < 2 {
+		select {
+		case <-ctx.Done():
+			t.Fatal("retry result not reported")
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+	res, _ = srv.WaitResult(ctx, "r1")
+	if res.Status != pb.RunStatus_RUN_STATUS_SUCCESS || res.Output != "resumed from offset=500" {
+		t.Fatalf("retry res=%v", res)
+	}
+	for _, run := range srv.Runs() {
+		if run.RunId == "r1" && (run.ProgressPercent != 80 || run.ProgressMessage != "resumed" || run.ProgressAt == 0) {
+			t.Fatalf("run progress not recorded: %v", run)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Package nacos 提供 scheduler.EndpointResolver 的 Nacos 服务发现实现，单独成包以便不使用 Nacos 时不引入 nacos-sdk-go 依赖。
+//
+//	r, err := nacos.New(nacos.Options{Servers: []string{"nacos:8848"}, NamespaceId: "prod", ServiceName: "iot-scheduler"})
+//	if err != nil { return err }
+//	defer r.Close()
+//	c, err := scheduler.New(scheduler.Config{EndpointResolver: r, AppName: ..., AppKey: ..., AppSecret: ...})
+package nacos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/clients"
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/naming_client"
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+
+	"github.com/sidchai/compkg/pkg/scheduler"
+)
+
+// Options Nacos 服务发现选项；连接字段与 pkg/config/source/nacos 一致。
+type Options struct {
+	// ServiceName scheduler 在 Nacos 注册的服务名，必填。
+	ServiceName string
+
+	// Group 服务分组，默认 "DEFAULT_GROUP"。
+	Group string
+
+	// Clusters 只取这些集群的实例（可选）。
+	Clusters []string
+
+	// PortMetadataKey 非空时优先使用实例 metadata 中该 key 的端口（服务以 HTTP 端口注册、gRPC 端口放在 metadata 时）。
+	PortMetadataKey string
+
+	// Client 复用已有的 naming client；非 nil 时忽略以下连接字段，Close 也不关闭它。
+	Client naming_client.INamingClient
+
+	// Servers Nacos 服务端地址列表，形如 "127.0.0.1:8848"。
+	Servers []string
+
+	// NamespaceId Nacos namespace 唯一 ID（注意不是 name）。
+	NamespaceId string
+
+	// Username/Password Nacos 鉴权（可选，开启鉴权后必填）。
+	Username string
+	Password string
+
+	// AccessKey/SecretKey 阿里云 Nacos 接入（可选）。
+	AccessKey string
+	SecretKey string
+
+	// LogDir/CacheDir/LogLevel SDK 内部使用，可选。
+	LogDir   string
+	CacheDir string
+	LogLevel string
+
+	// TimeoutMs 请求超时，默认 5000。
+	TimeoutMs uint64
+}
+
+// Resolver 实现 scheduler.EndpointResolver：返回服务下健康且启用的实例地址。
+type Resolver struct {
+	opts Options
+
+	mu    sync.Mutex
+	cli   naming_client.INamingClient
+	owned bool // cli 由本 Resolver 创建，Close 时关闭
+}
+
+// 类型断言：编译期校验实现接口。
+var _ scheduler.EndpointResolver = (*Resolver)(nil)
+
+// New 构造 Resolver 但不立即建立连接；连接在首次 Resolve 时按需创建。
+func New(opts Options) (*Resolver, error) {
+	if opts.ServiceName == "" {
+		return nil, errors.New("nacos: serviceName required")
+	}
+	if opts.Client == nil && len(opts.Servers) == 0 {
+		return nil, errors.New("nacos: servers or client required")
+	}
+	if opts.Group == "" {
+		opts.Group = "DEFAULT_GROUP"
+	}
+	if opts.TimeoutMs == 0 {
+		opts.TimeoutMs = 5000
+	}
+	if opts.LogLevel == "" {
+		opts.LogLevel = "warn"
+	}
+	return &Resolver{opts: opts, cli: opts.Client}, nil
+}
+
+// Resolve 查询健康实例（naming client 订阅后走本地缓存，可按 Config.EndpointRefreshInterval 频繁调用）。
+func (r *Resolver) Resolve(ctx context.Context) ([]string, error) {
+	cli, err := r.client()
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	instances, err := cli.SelectInstances(vo.SelectInstancesParam{
+		ServiceName: r.opts.ServiceName,
+		GroupName:   r.opts.Group,
+		Clusters:    r.opts.Clusters,
+		HealthyOnly: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("nacos: select %s/%s: %w", r.opts.Group, r.opts.ServiceName, err)
+	}
+	addrs := make([]string, 0, len(instances))
+	for _, ins := range instances {
+		if !ins.Enable || !ins.Healthy {
+			continue
+		}
+		port := strconv.FormatUint(ins.Port, 10)
+		if p := ins.Metadata[r.opts.PortMetadataKey]; r.opts.PortMetadataKey != "" && p != "" {
+			port = p
+		}
+		addrs = append(addrs, net.JoinHostPort(ins.Ip, port))
+	}
+	return addrs, nil
+}
+
+// Close 关闭 New 内部创建的 naming client；复用的 Options.Client 不关闭。
+func (r *Resolver) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.owned && r.cli != nil {
+		r.cli.CloseClient()
+		r.cli = nil
+	}
+}
+
+// client 懒初始化。
+func (r *Resolver) client() (naming_client.INamingClient, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cli != nil {
+		return r.cli, nil
+	}
+	serverConfigs := make([]constant.ServerConfig, 0, len(r.opts.Servers))
+	for _, addr := range r.opts.Servers {
+		host, portStr, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("nacos: invalid server %q: %w", addr, err)
+		}
+		port, err := strconv.ParseUint(portStr, 10, 64)
+		if err != nil || host == "" || port == 0 {
+			return nil, fmt.Errorf("nacos: invalid server %q", addr)
+		}
+		serverConfigs = append(serverConfigs, *constant.NewServerConfig(host, port))
+	}
+	clientCfg := *constant.NewClientConfig(
+		constant.WithNamespaceId(r.opts.NamespaceId),
+		constant.WithTimeoutMs(r.opts.TimeoutMs),
+		constant.WithUsername(r.opts.Username),
+		constant.WithPassword(r.opts.Password),
+		constant.WithAccessKey(r.opts.AccessKey),
+		constant.WithSecretKey(r.opts.SecretKey),
+		constant.WithLogDir(r.opts.LogDir),
+		constant.WithCacheDir(r.opts.CacheDir),
+		constant.WithLogLevel(r.opts.LogLevel),
+	)
+	cli, err := clients.NewNamingClient(vo.NacosClientParam{
+		ClientConfig:  &clientCfg,
+		ServerConfigs: serverConfigs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("nacos: new naming client: %w", err)
+	}
+	r.cli, r.owned = cli, true
+	return cli, nil
+}
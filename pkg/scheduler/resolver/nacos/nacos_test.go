@@ -0,0 +1,53 @@
+package nacos
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/clients/naming_client"
+	"github.com/nacos-group/nacos-sdk-go/v2/model"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+)
+
+// fakeNaming 只实现 SelectInstances 的 naming client。
+type fakeNaming struct {
+	naming_client.INamingClient
+	param     vo.SelectInstancesParam
+	instances []model.Instance
+}
+
+func (f *fakeNaming) SelectInstances(param vo.SelectInstancesParam) ([]model.Instance, error) {
+	f.param = param
+	return f.instances, nil
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	fake := &fakeNaming{instances: []model.Instance{
+		{Ip: "10.0.0.1", Port: 9090, Enable: true, Healthy: true},
+		{Ip: "10.0.0.2", Port: 8080, Enable: true, Healthy: true, Metadata: map[string]string{"grpc_port": "9091"}},
+		{Ip: "10.0.0.3", Port: 9090, Enable: false, Healthy: true},
+	}}
+	r, err := New(Options{Client: fake, ServiceName: "iot-scheduler", Clusters: []string{"sh"}, PortMetadataKey: "grpc_port"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	addrs, err := r.Resolve(context.Background())
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if want := []string{"10.0.0.1:9090", "10.0.0.2:9091"}; !slices.Equal(addrs, want) {
+		t.Fatalf("addrs=%v want %v", addrs, want)
+	}
+	if p := fake.param; p.ServiceName != "iot-scheduler" || p.GroupName != "DEFAULT_GROUP" || !p.HealthyOnly || !slices.Equal(p.Clusters, []string{"sh"}) {
+		t.Fatalf("unexpected query: %+v", p)
+	}
+	r.Close() // 复用的 client 不关闭（fake 未实现 CloseClient，调用会 panic）
+
+	if _, err := New(Options{Client: fake}); err == nil {
+		t.Fatal("missing serviceName must be rejected")
+	}
+	if _, err := New(Options{ServiceName: "s"}); err == nil {
+		t.Fatal("missing servers and client must be rejected")
+	}
+}
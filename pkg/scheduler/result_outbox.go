@@ -0,0 +1,281 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// outboxRecord 是落盘后的待上报 JobResult。
+//
+// 与 spoolTask 一致：文件名用单调递增序号承载顺序，Result 为 pb.JobResult 的 proto 二进制，
+// 进程重启后按序号升序扫描即可恢复上报顺序。
+type outboxRecord struct {
+	Seq      int64       `json:"seq"`
+	RunID    string      `json:"run_id"`
+	Result   []byte      `json:"result"`
+	QueuedAt int64       `json:"queued_at"`
+	Payload  *OffloadRef `json:"payload,omitempty"`
+}
+
+type outboxEntry struct {
+	seq     int64
+	result  *pb.JobResult
+	payload *OffloadRef // 结果送达后删除的转存 payload（PayloadOffloadDeleteOnComplete）；nil 表示无
+	path    string      // 未启用磁盘时为空
+	sentAt  time.Time   // 零值表示尚未写入 stream
+}
+
+// resultOutbox 是 JobResult 的上报发件箱。
+//
+// 设计约束：
+//   - handler 结束后结果一律先 put 进 outbox，再由当前 session 的 sender 发出；
+//     session 在 run 中途结束时结果留在 outbox，下次 Connect 成功后重放，避免服务端只能靠 timeout 兜底再白白重试
+//   - 按 run_id 去重：同一 run 的新结果覆盖旧结果，位置保持不变
+//   - stream.Send 成功只代表写进本地 HTTP/2 缓冲：已发送的结果先标记 sent，session 稳定超过确认窗口才移除；
+//     窗口内 session 断开则重新入队，下个 session 再发一次（服务端按 run_id 幂等）
+//   - 可选磁盘持久化（沿用 submitSpool 的"每条一个文件 + tmp 原子 rename"方案），进程重启后仍能重放
+//   - 超过 capacity 时淘汰最旧结果并记 warn，由服务端 timeout 兜底
+type resultOutbox struct {
+	capacity int
+	dir      string // 为空表示仅内存
+
+	mu      sync.Mutex
+	nextSeq int64
+	order   []string // run_id，按入箱顺序
+	entries map[string]*outboxEntry
+
+	notify chan struct{} // put 事件信号，唤醒当前 session 的 sender 立即 flush
+
+	dropped atomic.Int64 // 容量满被淘汰的结果数
+}
+
+func newResultOutbox(capacity int, diskEnabled bool, dir string) (*resultOutbox, error) {
+	o := &resultOutbox{
+		capacity: capacity,
+		nextSeq:  time.Now().UnixNano(),
+		entries:  make(map[string]*outboxEntry),
+		notify:   make(chan struct{}, 1),
+	}
+	if !diskEnabled {
+		return o, nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create scheduler result outbox dir: %w", err)
+	}
+	o.dir = dir
+	if err := o.reloadLocked(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// put 登记一条待上报结果；同 run_id 已存在时覆盖。payload 非 nil 时在结果确认送达后由 confirmSent 交回调用方删除。
+// 磁盘写失败只记 warn，结果仍保留在内存。
+func (o *resultOutbox) put(r *pb.JobResult, payload *OffloadRef) {
+	o.mu.Lock()
+	if old, ok := o.entries[r.RunId]; ok {
+		o.removeFileLocked(old)
+		old.result = r
+		old.payload = payload
+		old.sentAt = time.Time{}
+		o.persistLocked(old)
+	} else {
+		for len(o.order) >= o.capacity && len(o.order) > 0 {
+			evicted := o.entries[o.order[0]]
+			o.removeFileLocked(evicted)
+			delete(o.entries, o.order[0])
+			o.order = o.order[1:]
+			o.dropped.Add(1)
+			logger.Warnf("[scheduler-sdk] result outbox full, drop oldest run_id=%s", evicted.result.RunId)
+		}
+		e := &outboxEntry{seq: o.nextSeq, result: r, payload: payload}
+		o.nextSeq++
+		o.persistLocked(e)
+		o.entries[r.RunId] = e
+		o.order = append(o.order, r.RunId)
+	}
+	o.mu.Unlock()
+
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pending 按入箱顺序返回尚未写入 stream 的结果快照。
+func (o *resultOutbox) pending() []*pb.JobResult {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	out := make([]*pb.JobResult, 0, len(o.order))
+	for _, runID := range o.order {
+		if e := o.entries[runID]; e.sentAt.IsZero() {
+			out = append(out, e.result)
+		}
+	}
+	return out
+}
+
+// markSent 标记结果已写入 stream，等待 confirmSent 移除。r 须是 pending 返回的同一对象：
+// 若期间同 run_id 被新结果覆盖，则保留新结果等待下一轮。
+func (o *resultOutbox) markSent(r *pb.JobResult) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if e, ok := o.entries[r.RunId]; ok && e.result == r {
+		e.sentAt = time.Now()
+	}
+}
+
+// confirmSent 移除在 before 之前写入 stream 的结果：session 在此之后仍存活，视为已送达。
+// 返回这些结果登记的待删除 payload，由调用方在锁外删除。
+func (o *resultOutbox) confirmSent(before time.Time) []*OffloadRef {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var payloads []*OffloadRef
+	kept := o.order[:0]
+	for _, runID := range o.order {
+		e := o.entries[runID]
+		if !e.sentAt.IsZero() && e.sentAt.Before(before) {
+			o.removeFileLocked(e)
+			delete(o.entries, runID)
+			if e.payload != nil {
+				payloads = append(payloads, e.payload)
+			}
+			continue
+		}
+		kept = append(kept, runID)
+	}
+	o.order = kept
+	return payloads
+}
+
+// requeueSent 把已写入 stream 但未确认的结果重新置为待发送；session 结束时调用。
+func (o *resultOutbox) requeueSent() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, e := range o.entries {
+		e.sentAt = time.Time{}
+	}
+}
+
+func (o *resultOutbox) len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.order)
+}
+
+func (o *resultOutbox) persistLocked(e *outboxEntry) {
+	if o.dir == "" {
+		return
+	}
+	data, err := proto.Marshal(e.result)
+	if err != nil {
+		logger.Warnf("[scheduler-sdk] marshal outbox result run_id=%s err=%v", e.result.RunId, err)
+		return
+	}
+	rec, err := json.Marshal(outboxRecord{Seq: e.seq, RunID: e.result.RunId, Result: data, QueuedAt: time.Now().UnixNano(), Payload: e.payload})
+	if err != nil {
+		logger.Warnf("[scheduler-sdk] marshal outbox record run_id=%s err=%v", e.result.RunId, err)
+		return
+	}
+	finalPath := filepath.Join(o.dir, fmt.Sprintf("%020d.json", e.seq))
+	tmpPath := finalPath + ".tmp"
+	if err := os.WriteFile(tmpPath, rec, 0o600); err != nil {
+		logger.Warnf("[scheduler-sdk] write outbox tmp run_id=%s err=%v", e.result.RunId, err)
+		return
+	}
+	if err := os.Rename(tmpPath, finalPath); err != nil {
+		_ = os.Remove(tmpPath)
+		logger.Warnf("[scheduler-sdk] rename outbox record run_id=%s err=%v", e.result.RunId, err)
+		return
+	}
+	e.path = finalPath
+}
+
+func (o *resultOutbox) removeFileLocked(e *outboxEntry) {
+	if e.path == "" {
+		return
+	}
+	if err := os.Remove(e.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.Warnf("[scheduler-sdk] remove outbox record %s err=%v", e.path, err)
+	}
+	e.path = ""
+}
+
+// reloadLocked 扫描磁盘恢复上次进程未送达的结果；同 run_id 只保留序号最大的一条，损坏文件直接删除。
+func (o *resultOutbox) reloadLocked() error {
+	dirEntries, err := os.ReadDir(o.dir)
+	if err != nil {
+		return fmt.Errorf("read scheduler result outbox dir: %w", err)
+	}
+	loaded := make([]*outboxEntry, 0, len(dirEntries))
+	for _, de := range dirEntries {
+		if de.IsDir() || !strings.HasSuffix(de.Name(), ".json") {
+			continue
+		}
+		seq, err := strconv.ParseInt(strings.TrimSuffix(de.Name(), ".json"), 10, 64)
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(o.dir, de.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read scheduler result outbox %s: %w", path, err)
+		}
+		var rec outboxRecord
+		result := &pb.JobResult{}
+		if err := json.Unmarshal(data, &rec); err != nil || proto.Unmarshal(rec.Result, result) != nil || result.RunId == "" {
+			logger.Warnf("[scheduler-sdk] drop corrupted outbox record %s", path)
+			_ = os.Remove(path)
+			continue
+		}
+		loaded = append(loaded, &outboxEntry{seq: seq, result: result, payload: rec.Payload, path: path})
+		if seq >= o.nextSeq {
+			o.nextSeq = seq + 1
+		}
+	}
+	sort.Slice(loaded, func(i, j int) bool { return loaded[i].seq < loaded[j].seq })
+	for _, e := range loaded {
+		if old, ok := o.entries[e.result.RunId]; ok {
+			o.removeFileLocked(old)
+			old.seq, old.result, old.payload, old.path = e.seq, e.result, e.payload, e.path
+			continue
+		}
+		o.entries[e.result.RunId] = e
+		o.order = append(o.order, e.result.RunId)
+	}
+	for len(o.order) > o.capacity {
+		o.removeFileLocked(o.entries[o.order[0]])
+		delete(o.entries, o.order[0])
+		o.order = o.order[1:]
+	}
+	return nil
+}
+
+// PendingResults 返回尚未确认送达 scheduler 的 JobResult 数量（含磁盘重放的），用于业务方暴露监控指标。
+func (c *Client) PendingResults() int {
+	return c.resultOutbox.len()
+}
+
+// flushResultOutbox 把 outbox 中待发送的结果逐条写入当前 stream；写成功标记 sent，写失败返回 err 结束本 session。
+func (c *Client) flushResultOutbox(send func(*pb.WorkerMessage) error) error {
+	for _, r := range c.resultOutbox.pending() {
+		if err := send(&pb.WorkerMessage{Payload: &pb.WorkerMessage_Result{Result: r}}); err != nil {
+			return err
+		}
+		c.resultOutbox.markSent(r)
+	}
+	return nil
+}
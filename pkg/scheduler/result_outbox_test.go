@@ -0,0 +1,138 @@
+package scheduler
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+func TestResultOutbox_DedupByRunID(t *testing.T) {
+	o, err := newResultOutbox(10, false, "")
+	if err != nil {
+		t.Fatalf("newResultOutbox: %v", err)
+	}
+	o.put(&pb.JobResult{RunId: "r1", Status: pb.RunStatus_RUN_STATUS_FAILED}, nil)
+	o.put(&pb.JobResult{RunId: "r2", Status: pb.RunStatus_RUN_STATUS_SUCCESS}, nil)
+	o.put(&pb.JobResult{RunId: "r1", Status: pb.RunStatus_RUN_STATUS_SUCCESS}, nil)
+
+	got := o.pending()
+	if len(got) != 2 || got[0].RunId != "r1" || got[1].RunId != "r2" {
+		t.Fatalf("unexpected pending: %v", got)
+	}
+	if got[0].Status != pb.RunStatus_RUN_STATUS_SUCCESS {
+		t.Fatalf("dedup should keep the latest result, got %s", got[0].Status)
+	}
+}
+
+func TestResultOutbox_MarkSentKeepsNewerResult(t *testing.T) {
+	o, _ := newResultOutbox(10, false, "")
+	first := &pb.JobResult{RunId: "r1"}
+	o.put(first, nil)
+	snapshot := o.pending()
+	o.put(&pb.JobResult{RunId: "r1", Output: "newer"}, nil)
+
+	o.markSent(snapshot[0])
+	o.confirmSent(time.Now().Add(time.Second))
+	if o.len() != 1 || o.pending()[0].Output != "newer" {
+		t.Fatalf("stale snapshot must not drop newer result: %v", o.pending())
+	}
+}
+
+func TestResultOutbox_RequeueUnconfirmed(t *testing.T) {
+	o, _ := newResultOutbox(10, false, "")
+	o.put(&pb.JobResult{RunId: "r1"}, nil)
+	o.put(&pb.JobResult{RunId: "r2"}, nil)
+	for _, r := range o.pending() {
+		o.markSent(r)
+	}
+	if len(o.pending()) != 0 || o.len() != 2 {
+		t.Fatalf("sent results should wait for confirm, pending=%d len=%d", len(o.pending()), o.len())
+	}
+
+	// session 在确认窗口内断开：未确认结果重新待发送
+	o.requeueSent()
+	if got := o.pending(); len(got) != 2 || got[0].RunId != "r1" {
+		t.Fatalf("unexpected requeued: %v", got)
+	}
+
+	o.markSent(o.pending()[0])
+	o.confirmSent(time.Now().Add(time.Second))
+	if got := o.pending(); o.len() != 1 || len(got) != 1 || got[0].RunId != "r2" {
+		t.Fatalf("confirmed result should be removed, got %v", got)
+	}
+}
+
+func TestResultOutbox_CapacityDropsOldest(t *testing.T) {
+	o, _ := newResultOutbox(2, false, "")
+	for _, id := range []string{"r1", "r2", "r3"} {
+		o.put(&pb.JobResult{RunId: id}, nil)
+	}
+	got := o.pending()
+	if len(got) != 2 || got[0].RunId != "r2" || got[1].RunId != "r3" {
+		t.Fatalf("expect oldest evicted, got %v", got)
+	}
+}
+
+func TestResultOutbox_DiskReplayAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	o, err := newResultOutbox(10, true, dir)
+	if err != nil {
+		t.Fatalf("newResultOutbox: %v", err)
+	}
+	o.put(&pb.JobResult{RunId: "r1", Output: "a"}, nil)
+	o.put(&pb.JobResult{RunId: "r2", Output: "b"}, nil)
+	payload := &OffloadRef{Version: OffloadRefVersion, URL: "https://oss.test/p.bin"}
+	o.put(&pb.JobResult{RunId: "r1", Output: "a2"}, payload)
+	o.markSent(o.pending()[1])
+	o.confirmSent(time.Now().Add(time.Second))
+	// 损坏文件应被跳过并删除，不影响其余记录
+	if err := os.WriteFile(filepath.Join(dir, "00000000000000000001.json"), []byte("{torn"), 0o600); err != nil {
+		t.Fatalf("write corrupted: %v", err)
+	}
+
+	reloaded, err := newResultOutbox(10, true, dir)
+	if err != nil {
+		t.Fatalf("reload outbox: %v", err)
+	}
+	got := reloaded.pending()
+	if len(got) != 1 || got[0].RunId != "r1" || got[0].Output != "a2" {
+		t.Fatalf("unexpected replay: %v", got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "00000000000000000001.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("corrupted record should be removed, stat err=%v", err)
+	}
+	// 待删除的 payload 随结果落盘，重启后送达仍会交回删除
+	reloaded.markSent(got[0])
+	if refs := reloaded.confirmSent(time.Now().Add(time.Second)); len(refs) != 1 || refs[0].URL != payload.URL {
+		t.Fatalf("payload to delete after replay: %v", refs)
+	}
+}
+
+func TestClient_FlushResultOutbox(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	c.resultOutbox.put(&pb.JobResult{RunId: "r1"}, nil)
+	c.resultOutbox.put(&pb.JobResult{RunId: "r2"}, nil)
+
+	sendErr := errors.New("stream broken")
+	var sent []string
+	err = c.flushResultOutbox(func(m *pb.WorkerMessage) error {
+		if len(sent) == 1 {
+			return sendErr
+		}
+		sent = append(sent, m.GetResult().RunId)
+		return nil
+	})
+	if !errors.Is(err, sendErr) {
+		t.Fatalf("flush err got=%v", err)
+	}
+	if got := c.resultOutbox.pending(); len(got) != 1 || got[0].RunId != "r2" {
+		t.Fatalf("undelivered result must stay pending, got %v", c.resultOutbox.pending())
+	}
+}
@@ -0,0 +1,204 @@
+package scheduler
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// runQueue 执行准入：进程级 Config.MaxConcurrency + per-job 并发上限（WithMaxConcurrency）
+// + per-job 有界等待队列（WithQueue）。
+//
+// 任一并发上限已满时，开启了队列的 job 进入本 job 的等待堆；有 run 结束时，在所有可执行的 job 中
+// 选出优先级最高、派发最早的一条启动。未开启队列的 job 保持原语义：并发满即拒收。
+type runQueue struct {
+	mu      sync.Mutex
+	max     int // 进程级并发上限
+	running int
+	queued  int
+	seq     uint64
+	lanes   map[string]*jobLane
+}
+
+// jobLane 单个 job 的并发计数与等待堆。
+type jobLane struct {
+	maxConcurrency int // 0 表示不单独限制
+	queueSize      int
+	running        int
+	waiting        waitHeap
+}
+
+// dispatchTask 一条已通过 handler 查找、待执行或排队中的 Dispatch。
+type dispatchTask struct {
+	d       *pb.Dispatch
+	handler HandlerFunc
+	ctx     context.Context
+	cancel  context.CancelFunc
+	lease   *leaseCtx // timeout_sec>0 时为 ctx 的超时租约，ReportProgress 据此续期
+
+	acceptedAt time.Time    // 通过准入（含排队）的时间
+	startedAt  atomic.Int64 // handler 开始执行的 unix 纳秒；排队中为 0
+
+	seq   uint64 // 入队序号，同优先级同派发时间时保证 FIFO
+	index int    // 在 waitHeap 中的下标
+}
+
+// admitResult runQueue.admit 的结果。
+type admitResult int
+
+const (
+	admitRun      admitResult = iota // 占用执行槽位，立即执行
+	admitQueued                      // 进入等待队列
+	admitRejected                    // 并发与队列均已满
+)
+
+func newRunQueue(max int) *runQueue {
+	return &runQueue{max: max, lanes: make(map[string]*jobLane)}
+}
+
+// configure 设置 job 的并发上限与队列容量；重复注册时覆盖，已在执行 / 排队的 run 不受影响。
+func (q *runQueue) configure(jobName string, maxConcurrency, queueSize int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lane := q.laneLocked(jobName)
+	lane.maxConcurrency, lane.queueSize = maxConcurrency, queueSize
+}
+
+// admit 尝试为 t 占用执行槽位，失败时尝试排队。
+func (q *runQueue) admit(t *dispatchTask) admitResult {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lane := q.laneLocked(t.d.JobName)
+	if q.canRunLocked(lane) {
+		q.running++
+		lane.running++
+		return admitRun
+	}
+	if len(lane.waiting) >= lane.queueSize {
+		return admitRejected
+	}
+	q.seq++
+	t.seq = q.seq
+	heap.Push(&lane.waiting, t)
+	q.queued++
+	return admitQueued
+}
+
+// done 释放 jobName 的一个执行槽位，返回因此可以启动的排队任务（已为其占用槽位）。
+func (q *runQueue) done(jobName string) []*dispatchTask {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lane := q.laneLocked(jobName)
+	lane.running--
+	q.running--
+
+	var ready []*dispatchTask
+	for {
+		var best *jobLane
+		for _, l := range q.lanes {
+			if len(l.waiting) == 0 || !q.canRunLocked(l) {
+				continue
+			}
+			if best == nil || runsBefore(l.waiting[0], best.waiting[0]) {
+				best = l
+			}
+		}
+		if best == nil {
+			return ready
+		}
+		t := heap.Pop(&best.waiting).(*dispatchTask)
+		q.queued--
+		q.running++
+		best.running++
+		ready = append(ready, t)
+	}
+}
+
+// remove 把排队中的 t 移出队列（取消或排队超时）；t 不在队列中返回 false。
+func (q *runQueue) remove(t *dispatchTask) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lane, ok := q.lanes[t.d.JobName]
+	if !ok || t.index < 0 || t.index >= len(lane.waiting) || lane.waiting[t.index] != t {
+		return false
+	}
+	heap.Remove(&lane.waiting, t.index)
+	q.queued--
+	return true
+}
+
+// stats 返回正在执行与排队中的 run 数，用于心跳上报。
+func (q *runQueue) stats() (running, queued int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.running, q.queued
+}
+
+// laneStats 返回 job 的并发 / 队列配置与当前执行、排队数，供 DebugHandler 展示。
+func (q *runQueue) laneStats(jobName string) (maxConcurrency, queueSize, running, queued int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	lane := q.laneLocked(jobName)
+	return lane.maxConcurrency, lane.queueSize, lane.running, len(lane.waiting)
+}
+
+func (q *runQueue) canRunLocked(lane *jobLane) bool {
+	return q.running < q.max && (lane.maxConcurrency == 0 || lane.running < lane.maxConcurrency)
+}
+
+func (q *runQueue) laneLocked(jobName string) *jobLane {
+	lane, ok := q.lanes[jobName]
+	if !ok {
+		lane = &jobLane{}
+		q.lanes[jobName] = lane
+	}
+	return lane
+}
+
+// waitHeap 按 priority（HIGH → NORMAL → LOW，未设置视为 NORMAL）、dispatched_at、入队序号排序的小顶堆。
+type waitHeap []*dispatchTask
+
+func (h waitHeap) Len() int           { return len(h) }
+func (h waitHeap) Less(i, j int) bool { return runsBefore(h[i], h[j]) }
+func (h waitHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index, h[j].index = i, j
+}
+
+func (h *waitHeap) Push(x any) {
+	t := x.(*dispatchTask)
+	t.index = len(*h)
+	*h = append(*h, t)
+}
+
+func (h *waitHeap) Pop() any {
+	old := *h
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	t.index = -1
+	return t
+}
+
+// runsBefore a 是否先于 b 出队；堆内排序与跨 job 选取下一条共用。
+func runsBefore(a, b *dispatchTask) bool {
+	if ra, rb := priorityRank(a.d.Priority), priorityRank(b.d.Priority); ra != rb {
+		return ra < rb
+	}
+	if a.d.DispatchedAt != b.d.DispatchedAt {
+		return a.d.DispatchedAt < b.d.DispatchedAt
+	}
+	return a.seq < b.seq
+}
+
+// priorityRank 数值越小越先执行。
+func priorityRank(p pb.Priority) int {
+	if p == pb.Priority_PRIORITY_UNSPECIFIED {
+		return int(pb.Priority_PRIORITY_NORMAL)
+	}
+	return int(p)
+}
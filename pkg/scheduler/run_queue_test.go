@@ -0,0 +1,258 @@
+package scheduler
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// blockingHandler 记录开始顺序，并阻塞到 release 收到对应 run_id。
+type blockingHandler struct {
+	mu      sync.Mutex
+	started []string
+	startCh chan string
+	release map[string]chan struct{}
+}
+
+func newBlockingHandler(runIDs ...string) *blockingHandler {
+	h := &blockingHandler{startCh: make(chan string, len(runIDs)), release: make(map[string]chan struct{})}
+	for _, id := range runIDs {
+		h.release[id] = make(chan struct{})
+	}
+	return h
+}
+
+func (h *blockingHandler) handle(ctx context.Context, job *Job) (string, error) {
+	h.mu.Lock()
+	h.started = append(h.started, job.RunID)
+	h.mu.Unlock()
+	h.startCh <- job.RunID
+	select {
+	case <-h.release[job.RunID]:
+		return "", nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func (h *blockingHandler) waitStart(t *testing.T, want string) {
+	t.Helper()
+	select {
+	case got := <-h.startCh:
+		if got != want {
+			t.Fatalf("started %s, want %s", got, want)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("run %s not started", want)
+	}
+}
+
+func drainAcks(ch chan *pb.WorkerMessage) map[string]*pb.JobAck {
+	acks := make(map[string]*pb.JobAck)
+	for {
+		select {
+		case m := <-ch:
+			if a := m.GetAck(); a != nil {
+				acks[a.RunId] = a
+			}
+		default:
+			return acks
+		}
+	}
+}
+
+func waitResult(t *testing.T, c *Client, runID string) *pb.JobResult {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, r := range c.resultOutbox.pending() {
+			if r.RunId == runID {
+				return r
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("no result for %s", runID)
+	return nil
+}
+
+func TestClient_PerJobLimitQueuesByPriority(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", MaxConcurrency: 3})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	slow := newBlockingHandler("s1", "s2", "s3", "s4")
+	fast := newBlockingHandler("f1")
+	c.RegisterHandler("slow", slow.handle, WithMaxConcurrency(1), WithQueue(2))
+	c.RegisterHandler("fast", fast.handle)
+
+	ctx := context.Background()
+	sendCh := make(chan *pb.WorkerMessage, 16)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "s1", JobName: "slow", DispatchedAt: 1}, sendCh)
+	slow.waitStart(t, "s1")
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "s2", JobName: "slow", DispatchedAt: 2, Priority: pb.Priority_PRIORITY_LOW}, sendCh)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "s3", JobName: "slow", DispatchedAt: 3, Priority: pb.Priority_PRIORITY_HIGH}, sendCh)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "s4", JobName: "slow", DispatchedAt: 4}, sendCh)
+	// slow 占满自身上限，但不影响其他 handler
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "f1", JobName: "fast"}, sendCh)
+	fast.waitStart(t, "f1")
+
+	acks := drainAcks(sendCh)
+	for _, id := range []string{"s1", "s2", "s3", "f1"} {
+		if !acks[id].GetAccepted() {
+			t.Fatalf("run %s should be accepted: %v", id, acks[id])
+		}
+	}
+	if a := acks["s4"]; a.GetAccepted() || a.GetReason() != "queue full" {
+		t.Fatalf("s4 should be rejected with queue full: %v", a)
+	}
+	if running, queued := c.runQueue.stats(); running != 2 || queued != 2 {
+		t.Fatalf("stats running=%d queued=%d", running, queued)
+	}
+
+	// 高优先级的 s3 先于更早派发的 s2
+	close(slow.release["s1"])
+	slow.waitStart(t, "s3")
+	close(slow.release["s3"])
+	slow.waitStart(t, "s2")
+	close(slow.release["s2"])
+	close(fast.release["f1"])
+	waitResult(t, c, "s2")
+	if running, queued := c.runQueue.stats(); running != 0 || queued != 0 {
+		t.Fatalf("stats after drain running=%d queued=%d", running, queued)
+	}
+}
+
+func TestClient_GlobalLimitWithoutQueueRejects(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", MaxConcurrency: 1})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	h := newBlockingHandler("r1", "r2", "r3")
+	c.RegisterHandler("plain", h.handle)
+	c.RegisterHandler("queued", h.handle, WithQueue(1))
+
+	ctx := context.Background()
+	sendCh := make(chan *pb.WorkerMessage, 8)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r1", JobName: "plain"}, sendCh)
+	h.waitStart(t, "r1")
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r2", JobName: "plain"}, sendCh)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r3", JobName: "queued"}, sendCh)
+	acks := drainAcks(sendCh)
+	if a := acks["r2"]; a.GetAccepted() || a.GetReason() != "inflight full" {
+		t.Fatalf("r2 ack=%v", a)
+	}
+	if !acks["r3"].GetAccepted() {
+		t.Fatalf("r3 should wait in queue: %v", acks["r3"])
+	}
+
+	// 排队中的 run 被取消：立即上报 CANCELED，handler 不执行
+	c.onCancel(ctx, &pb.Cancel{RunId: "r3", Reason: "test"})
+	res := waitResult(t, c, "r3")
+	if res.Status != pb.RunStatus_RUN_STATUS_CANCELED || !strings.Contains(res.Error, "queued") {
+		t.Fatalf("r3 result=%v", res)
+	}
+	close(h.release["r1"])
+	waitResult(t, c, "r1")
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.started) != 1 {
+		t.Fatalf("canceled run must not start, started=%v", h.started)
+	}
+}
+
+func TestClient_QueuedRunTimeoutFreesQueue(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", MaxConcurrency: 1})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	h := newBlockingHandler("r1", "r2", "r3")
+	c.RegisterHandler("export", h.handle, WithQueue(1))
+
+	ctx := context.Background()
+	sendCh := make(chan *pb.WorkerMessage, 8)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r1", JobName: "export"}, sendCh)
+	h.waitStart(t, "r1")
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r2", JobName: "export", TimeoutSec: 1}, sendCh)
+
+	// r1 仍在执行，排队中的 r2 到期即出队上报 TIMEOUT，腾出队列容量
+	if res := waitResult(t, c, "r2"); res.Status != pb.RunStatus_RUN_STATUS_TIMEOUT {
+		t.Fatalf("r2 result=%v", res)
+	}
+	if _, queued := c.runQueue.stats(); queued != 0 {
+		t.Fatalf("expired run still queued=%d", queued)
+	}
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r3", JobName: "export"}, sendCh)
+	if a := drainAcks(sendCh)["r3"]; !a.GetAccepted() {
+		t.Fatalf("r3 should take the freed queue slot: %v", a)
+	}
+	close(h.release["r1"])
+	h.waitStart(t, "r3")
+	close(h.release["r3"])
+	waitResult(t, c, "r3")
+}
+
+func TestClient_DuplicateRunIDRejected(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	h := newBlockingHandler("r1")
+	c.RegisterHandler("export", h.handle)
+
+	ctx := context.Background()
+	sendCh := make(chan *pb.WorkerMessage, 8)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r1", JobName: "export"}, sendCh)
+	h.waitStart(t, "r1")
+	drainAcks(sendCh)
+	c.onDispatch(ctx, &pb.Dispatch{RunId: "r1", JobName: "export"}, sendCh)
+	if a := drainAcks(sendCh)["r1"]; a.GetAccepted() || a.GetReason() != "run already inflight" {
+		t.Fatalf("duplicate ack=%v", a)
+	}
+
+	// 重复派发不影响原 run 的取消句柄
+	c.onCancel(ctx, &pb.Cancel{RunId: "r1", Reason: "test"})
+	if res := waitResult(t, c, "r1"); res.Status != pb.RunStatus_RUN_STATUS_CANCELED {
+		t.Fatalf("r1 result=%v", res)
+	}
+}
+
+func TestIntegration_HeartbeatReportsQueueDepth(t *testing.T) {
+	h := newBlockingHandler("r1", "r2")
+	srv, _ := startFakeClientWith(t, schedulertest.Options{}, nil, func(c *Client) {
+		c.RegisterHandler("slow", h.handle, WithMaxConcurrency(1), WithQueue(4))
+	})
+	ctx := waitCtx(t)
+	for _, id := range []string{"r1", "r2"} {
+		if err := srv.Dispatch(&pb.Dispatch{RunId: id, JobName: "slow"}); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+		if ack, err := srv.WaitAck(ctx, id); err != nil || !ack.Accepted {
+			t.Fatalf("ack=%v err=%v", ack, err)
+		}
+	}
+	h.waitStart(t, "r1")
+
+	for {
+		var hb *pb.Heartbeat
+		if hbs := srv.Heartbeats(); len(hbs) > 0 {
+			hb = hbs[len(hbs)-1]
+		}
+		if hb != nil && hb.Queued == 1 && hb.Inflight == 1 {
+			break
+		}
+		if err := srv.WaitHeartbeats(ctx, len(srv.Heartbeats())+1); err != nil {
+			t.Fatalf("no heartbeat with queue depth: last=%v", hb)
+		}
+	}
+	close(h.release["r1"])
+	close(h.release["r2"])
+	if _, err := srv.WaitResult(ctx, "r2"); err != nil {
+		t.Fatalf("WaitResult: %v", err)
+	}
+}
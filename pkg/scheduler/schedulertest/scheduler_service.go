@@ -0,0 +1,752 @@
+package schedulertest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// SchedulerService 的 fake 实现：run / job 状态全部保存在内存中。
+//
+// 除 SubmitTask / SubmitTasks（签名在请求体内）外，其余 RPC 校验 SDK 通过 metadata 附带的签名（x-app-name / x-app-key /
+// x-nonce / x-ts / x-signature），只能操作本应用的 job；附带 "authorization: Bearer <Options.AdminToken>" 时按 JWT 鉴权，
+// 视为管理员、不受 job 归属限制。测试代码直接调用 Server 方法（无 incoming metadata）时视为可信调用方。
+
+// adminOperator JWT 调用方的操作人（fake 不解析 JWT，token 正确即视为该用户），用作 pending change 的 proposer。
+const adminOperator = "schedulertest-admin"
+
+// authorize 校验 metadata 中的 JWT 或签名，返回调用方（JWT 为 adminOperator，签名为应用名）。
+func (s *Server) authorize(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return s.opts.AppName, nil
+	}
+	get := func(k string) string {
+		if v := md.Get(k); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	if auth := get("authorization"); auth != "" {
+		if token, ok := strings.CutPrefix(auth, "Bearer "); !ok || token != s.opts.AdminToken {
+			return "", status.Error(codes.Unauthenticated, "invalid authorization token")
+		}
+		return adminOperator, nil
+	}
+	ts, err := strconv.ParseInt(get("x-ts"), 10, 64)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "missing or invalid x-ts")
+	}
+	appName := get("x-app-name")
+	if err := s.verify(appName, get("x-app-key"), get("x-signature"), get("x-nonce"), ts); err != nil {
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	}
+	return appName, nil
+}
+
+// ownedJobLocked 取 job 并校验归属（adminOperator 不受限）；调用方须持有 s.mu。
+func (s *Server) ownedJobLocked(caller, jobName string) (*pb.Job, error) {
+	job, ok := s.jobs[jobName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", jobName)
+	}
+	if caller != adminOperator && job.AppName != "" && job.AppName != caller {
+		return nil, status.Errorf(codes.PermissionDenied, "job %s belongs to app %s", jobName, job.AppName)
+	}
+	return job, nil
+}
+
+// newRunLocked 登记一条 PENDING run；AutoDispatch 且有非 draining 的 worker 注册了该 job 时返回待发送的 Dispatch。
+// 调用方须持有 s.mu，并在释放锁后调用 sendDispatch。
+func (s *Server) newRunLocked(run *pb.Run) (*session, *pb.Dispatch) {
+	s.registerRunLocked(run)
+	return s.dispatchLocked(run)
+}
+
+// registerRunLocked 分配 run_id 并登记为 PENDING，不派发。
+func (s *Server) registerRunLocked(run *pb.Run) {
+	run.RunId = s.nextRunIDLocked()
+	run.Status = pb.RunStatus_RUN_STATUS_PENDING
+	run.CreatedAt = time.Now().Unix()
+	s.runs[run.RunId] = run
+	if cp, ok := s.checkpoint[run.ParentRunId]; ok {
+		// RetryRun：新 run 继承原 run 的断点
+		s.checkpoint[run.RunId] = cp
+	}
+	s.notifyLocked()
+}
+
+// dispatchLocked 为 PENDING run 挑选 worker；未开启 AutoDispatch 或没有可用 worker 时返回 nil。
+func (s *Server) dispatchLocked(run *pb.Run) (*session, *pb.Dispatch) {
+	if !s.opts.AutoDispatch {
+		return nil, nil
+	}
+	priority := run.Priority
+	if priority == pb.Priority_PRIORITY_UNSPECIFIED {
+		priority = s.jobs[run.JobName].GetPriority()
+	}
+	for i := len(s.sessions) - 1; i >= 0; i-- {
+		if s.sessions[i].draining || !s.sessions[i].handlerJobs[run.JobName] {
+			continue
+		}
+		run.Status = pb.RunStatus_RUN_STATUS_DISPATCHED
+		run.DispatchedAt = time.Now().UnixMilli()
+		return s.sessions[i], &pb.Dispatch{
+			RunId:        run.RunId,
+			JobName:      run.JobName,
+			BizKey:       run.BizKey,
+			Payload:      run.Payload,
+			TriggerType:  run.TriggerType,
+			RetryCount:   run.RetryCount,
+			ShardIndex:   run.ShardIndex,
+			ShardTotal:   run.ShardTotal,
+			TraceId:      run.TraceId,
+			SpanId:       run.SpanId,
+			DispatchedAt: time.Now().Unix(),
+			Priority:     priority,
+			Checkpoint:   s.checkpoint[run.RunId],
+		}
+	}
+	return nil, nil
+}
+
+// delayRunLocked 登记延迟 run：保持 PENDING、next_run_at 为到期秒数，到期后按 AutoDispatch 派发；
+// 到期前被取消的 run 不再派发。
+func (s *Server) delayRunLocked(run *pb.Run, runAt time.Time) {
+	run.NextRunAt = (runAt.UnixMilli() + 999) / 1000
+	s.registerRunLocked(run)
+	runID := run.RunId
+	time.AfterFunc(time.Until(runAt), func() {
+		s.mu.Lock()
+		run, ok := s.runs[runID]
+		if !ok || run.Status != pb.RunStatus_RUN_STATUS_PENDING {
+			s.mu.Unlock()
+			return
+		}
+		run.NextRunAt = 0
+		s.notifyLocked()
+		sess, d := s.dispatchLocked(run)
+		s.mu.Unlock()
+		s.sendDispatch(sess, d)
+	})
+}
+
+// sendDispatch 发送 newRunLocked 产生的 Dispatch；发送失败时 run 标记为 DISPATCH_FAIL。
+func (s *Server) sendDispatch(sess *session, d *pb.Dispatch) {
+	if sess == nil {
+		return
+	}
+	if err := sess.send(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Dispatch{Dispatch: d}}); err != nil {
+		s.mu.Lock()
+		s.runs[d.RunId].Status = pb.RunStatus_RUN_STATUS_DISPATCH_FAIL
+		s.notifyLocked()
+		s.mu.Unlock()
+	}
+}
+
+// SubmitTask 校验签名 → biz_key 去重 → 登记 PENDING run；AutoDispatch 时立即派发，
+// run_at 晚于当前时间时延迟到 run_at 再派发。
+func (s *Server) SubmitTask(_ context.Context, req *pb.SubmitTaskRequest) (*pb.SubmitTaskResponse, error) {
+	if err := s.verify(req.AppName, req.AppKey, req.Signature, req.Nonce, req.Ts); err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	s.mu.Lock()
+	if s.submitErr != nil {
+		err := s.submitErr
+		s.mu.Unlock()
+		return nil, err
+	}
+	resp, sess, d, err := s.submitLocked(req.AppName, &pb.SubmitTaskItem{
+		JobName:         req.JobName,
+		BizKey:          req.BizKey,
+		Payload:         req.Payload,
+		DedupeWindowSec: req.DedupeWindowSec,
+		TraceId:         req.TraceId,
+		SpanId:          req.SpanId,
+		RunAt:           req.RunAt,
+		Priority:        req.Priority,
+	})
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	s.sendDispatch(sess, d)
+	return resp, nil
+}
+
+// SubmitTasks 批量版 SubmitTask：整批校验一次签名，逐条去重 / 登记，单条失败写入对应结果的 code / error。
+// FailSubmit 注入的错误作用于整批；Options.DisableSubmitTasks 时返回 Unimplemented。
+func (s *Server) SubmitTasks(_ context.Context, req *pb.SubmitTasksRequest) (*pb.SubmitTasksResponse, error) {
+	if s.opts.DisableSubmitTasks {
+		return nil, status.Error(codes.Unimplemented, "schedulertest: SubmitTasks disabled")
+	}
+	if err := s.verify(req.AppName, req.AppKey, req.Signature, req.Nonce, req.Ts); err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if len(req.Tasks) > maxSubmitBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tasks per batch, got %d", maxSubmitBatch, len(req.Tasks))
+	}
+	type pending struct {
+		sess *session
+		d    *pb.Dispatch
+	}
+	resp := &pb.SubmitTasksResponse{Results: make([]*pb.SubmitTaskResult, len(req.Tasks))}
+	var dispatches []pending
+	s.mu.Lock()
+	if s.submitErr != nil {
+		err := s.submitErr
+		s.mu.Unlock()
+		return nil, err
+	}
+	s.batchSubmits++
+	for i, item := range req.Tasks {
+		r, sess, d, err := s.submitLocked(req.AppName, item)
+		if err != nil {
+			st := status.Convert(err)
+			resp.Results[i] = &pb.SubmitTaskResult{Code: int32(st.Code()), Error: st.Message()}
+			continue
+		}
+		resp.Results[i] = &pb.SubmitTaskResult{RunId: r.RunId, Status: r.Status, Dedup: r.Dedup}
+		if sess != nil {
+			dispatches = append(dispatches, pending{sess, d})
+		}
+	}
+	s.mu.Unlock()
+	for _, p := range dispatches {
+		s.sendDispatch(p.sess, p.d)
+	}
+	return resp, nil
+}
+
+// submitLocked 单条提交：biz_key 去重 → 登记 PENDING（或延迟）run；调用方须持有 s.mu，释放锁后 sendDispatch。
+func (s *Server) submitLocked(appName string, item *pb.SubmitTaskItem) (*pb.SubmitTaskResponse, *session, *pb.Dispatch, error) {
+	if item.JobName == "" {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, "job_name required")
+	}
+	dedupKey := item.JobName + "\x00" + item.BizKey
+	if item.BizKey != "" && item.DedupeWindowSec > 0 {
+		if e, ok := s.dedup[dedupKey]; ok && time.Now().Before(e.expiresAt) {
+			return &pb.SubmitTaskResponse{RunId: e.runID, Status: s.runs[e.runID].GetStatus(), Dedup: true}, nil, nil, nil
+		}
+	}
+	run := &pb.Run{
+		JobName:     item.JobName,
+		AppName:     appName,
+		BizKey:      item.BizKey,
+		Payload:     item.Payload,
+		TriggerType: pb.TriggerType_TRIGGER_TYPE_API,
+		TraceId:     item.TraceId,
+		SpanId:      item.SpanId,
+		Priority:    item.Priority,
+	}
+	var (
+		sess *session
+		d    *pb.Dispatch
+	)
+	if runAt := time.UnixMilli(item.RunAt); item.RunAt > 0 && runAt.After(time.Now()) {
+		s.delayRunLocked(run, runAt)
+	} else {
+		sess, d = s.newRunLocked(run)
+	}
+	if item.BizKey != "" && item.DedupeWindowSec > 0 {
+		s.dedup[dedupKey] = dedupEntry{runID: run.RunId, expiresAt: time.Now().Add(time.Duration(item.DedupeWindowSec) * time.Second)}
+	}
+	return &pb.SubmitTaskResponse{RunId: run.RunId, Status: pb.RunStatus_RUN_STATUS_PENDING}, sess, d, nil
+}
+
+// GetRun 返回 run 当前状态的副本。
+func (s *Server) GetRun(ctx context.Context, req *pb.GetRunRequest) (*pb.Run, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	run, ok := s.runs[req.RunId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+	}
+	return proto.Clone(run).(*pb.Run), nil
+}
+
+// CancelRun 把未结束的 run 标记为 CANCELED，并向 worker 推送 Cancel。
+func (s *Server) CancelRun(ctx context.Context, req *pb.CancelRunRequest) (*pb.CancelRunResponse, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	run, ok := s.runs[req.RunId]
+	if !ok {
+		s.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+	}
+	if isTerminal(run.Status) {
+		s.mu.Unlock()
+		return &pb.CancelRunResponse{Ok: false, Error: "run already finished: " + run.Status.String()}, nil
+	}
+	wasRunning := run.Status == pb.RunStatus_RUN_STATUS_DISPATCHED || run.Status == pb.RunStatus_RUN_STATUS_RUNNING
+	run.Status = pb.RunStatus_RUN_STATUS_CANCELED
+	run.Error = req.Reason
+	s.notifyLocked()
+	s.mu.Unlock()
+	if wasRunning {
+		_ = s.SendCancel(req.RunId, req.Reason)
+	}
+	return &pb.CancelRunResponse{Ok: true}, nil
+}
+
+// WatchRun 先推送 run 当前快照，之后每次变化（状态 / 进度 / 结果）推送一次，run 结束后关闭流。
+// fake 不会自动重试，FAILED / TIMEOUT / DISPATCH_FAIL 同样视为结束；Options.DisableWatchRun 时返回 Unimplemented。
+func (s *Server) WatchRun(req *pb.WatchRunRequest, stream pb.SchedulerService_WatchRunServer) error {
+	if s.opts.DisableWatchRun {
+		return status.Error(codes.Unimplemented, "schedulertest: WatchRun disabled")
+	}
+	ctx := stream.Context()
+	if _, err := s.authorize(ctx); err != nil {
+		return err
+	}
+	var last *pb.Run
+	for {
+		var snap *pb.Run
+		found := true
+		err := s.waitFor(ctx, func() bool {
+			run, ok := s.runs[req.RunId]
+			if !ok {
+				found = false
+				return true
+			}
+			if last != nil && proto.Equal(last, run) {
+				return false
+			}
+			snap = proto.Clone(run).(*pb.Run)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if !found {
+			return status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+		}
+		if err := stream.Send(&pb.RunEvent{Run: snap}); err != nil {
+			return err
+		}
+		if isTerminal(snap.Status) || snap.Status == pb.RunStatus_RUN_STATUS_DISPATCH_FAIL {
+			return nil
+		}
+		last = snap
+	}
+}
+
+// ListRuns 按 job_name / app_name / status / biz_key_like / worker_id / since / until 过滤，按 run_id 升序分页。
+func (s *Server) ListRuns(ctx context.Context, req *pb.ListRunsRequest) (*pb.ListRunsResponse, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	matched := make([]*pb.Run, 0, len(s.runs))
+	for _, r := range s.runs {
+		switch {
+		case req.JobName != "" && r.JobName != req.JobName,
+			req.AppName != "" && r.AppName != req.AppName,
+			req.Status != pb.RunStatus_RUN_STATUS_UNSPECIFIED && r.Status != req.Status,
+			req.BizKeyLike != "" && !strings.Contains(r.BizKey, req.BizKeyLike),
+			req.WorkerId != "" && r.WorkerId != req.WorkerId,
+			req.ParentRunId != "" && r.ParentRunId != req.ParentRunId,
+			req.Since > 0 && r.CreatedAt < req.Since,
+			req.Until > 0 && r.CreatedAt > req.Until:
+			continue
+		}
+		matched = append(matched, proto.Clone(r).(*pb.Run))
+	}
+	sort.Slice(matched, func(i, j int) bool { return runSeq(matched[i].RunId) < runSeq(matched[j].RunId) })
+	return &pb.ListRunsResponse{Runs: pageOf(matched, req.Page, req.PageSize), Total: int64(len(matched))}, nil
+}
+
+// RetryRun 以原 run 的参数新建一条 run（retry_count+1，parent_run_id 指向原 run），并继承原 run 的 checkpoint。
+func (s *Server) RetryRun(ctx context.Context, req *pb.RetryRunRequest) (*pb.RetryRunResponse, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	old, ok := s.runs[req.RunId]
+	if !ok {
+		s.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "run %s not found", req.RunId)
+	}
+	if !isTerminal(old.Status) {
+		s.mu.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "run %s not finished", req.RunId)
+	}
+	run := &pb.Run{
+		JobName:     old.JobName,
+		AppName:     old.AppName,
+		BizKey:      old.BizKey,
+		Payload:     old.Payload,
+		TriggerType: pb.TriggerType_TRIGGER_TYPE_RETRY,
+		RetryCount:  old.RetryCount + 1,
+		ParentRunId: old.RunId,
+		TraceId:     old.TraceId,
+		SpanId:      old.SpanId,
+	}
+	sess, d := s.newRunLocked(run)
+	runID := run.RunId
+	s.mu.Unlock()
+
+	s.sendDispatch(sess, d)
+	return &pb.RetryRunResponse{NewRunId: runID}, nil
+}
+
+// GetJob 返回已创建的 job；不存在返回 NotFound（EnsureJob 据此进入 CreateJob）。
+func (s *Server) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.Job, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[req.JobName]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "job %s not found", req.JobName)
+	}
+	return proto.Clone(job).(*pb.Job), nil
+}
+
+// CreateJob 登记 job；同名已存在返回 AlreadyExists。app_name 为空时取调用方应用（JWT 调用方取 Options.AppName），新 job 状态为 ready。
+func (s *Server) CreateJob(ctx context.Context, req *pb.CreateJobRequest) (*pb.Job, error) {
+	caller, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Job == nil || req.Job.JobName == "" {
+		return nil, status.Error(codes.InvalidArgument, "job.job_name required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[req.Job.JobName]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "job %s already exists", req.Job.JobName)
+	}
+	now := time.Now().Unix()
+	job := proto.Clone(req.Job).(*pb.Job)
+	if job.AppName == "" {
+		job.AppName = caller
+		if caller == adminOperator {
+			job.AppName = s.opts.AppName
+		}
+	}
+	if job.Status == "" {
+		job.Status = "ready"
+	}
+	job.Id = int64(len(s.jobs) + 1)
+	job.CreatedAt, job.UpdatedAt = now, now
+	s.jobs[job.JobName] = job
+	s.notifyLocked()
+	return proto.Clone(job).(*pb.Job), nil
+}
+
+// UpdateJob 覆盖 job 配置；require_approval 或原 job 为 critical 时只登记一条 pending change 并返回旧配置。
+func (s *Server) UpdateJob(ctx context.Context, req *pb.UpdateJobRequest) (*pb.Job, error) {
+	caller, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Job == nil || req.Job.JobName == "" {
+		return nil, status.Error(codes.InvalidArgument, "job.job_name required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, err := s.ownedJobLocked(caller, req.Job.JobName)
+	if err != nil {
+		return nil, err
+	}
+	next := proto.Clone(req.Job).(*pb.Job)
+	next.Id, next.AppName, next.Status = job.Id, job.AppName, job.Status
+	next.CreatedAt, next.CreatedBy = job.CreatedAt, job.CreatedBy
+	next.UpdatedAt = time.Now().Unix()
+	if req.RequireApproval || job.Critical {
+		s.changeSeq++
+		s.changes = append(s.changes, &pb.PendingChange{
+			Id:         s.changeSeq,
+			TargetType: "job",
+			TargetId:   job.JobName,
+			Proposer:   caller,
+			ProposedAt: next.UpdatedAt,
+			ExpiresAt:  next.UpdatedAt + int64((24 * time.Hour).Seconds()),
+			Status:     "pending",
+		})
+		s.staged[s.changeSeq] = next
+		s.notifyLocked()
+		return proto.Clone(job).(*pb.Job), nil
+	}
+	s.jobs[next.JobName] = next
+	s.notifyLocked()
+	return proto.Clone(next).(*pb.Job), nil
+}
+
+// DeleteJob 删除 job；confirm 须等于 job 名，否则返回 ok=false。
+func (s *Server) DeleteJob(ctx context.Context, req *pb.DeleteJobRequest) (*pb.DeleteJobResponse, error) {
+	caller, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.ownedJobLocked(caller, req.JobName); err != nil {
+		return nil, err
+	}
+	if req.Confirm != req.JobName {
+		return &pb.DeleteJobResponse{Ok: false, Error: "confirm must equal job_name"}, nil
+	}
+	delete(s.jobs, req.JobName)
+	s.notifyLocked()
+	return &pb.DeleteJobResponse{Ok: true}, nil
+}
+
+// ListJobs 按 app_name / keyword / trigger_type / status / priority 过滤，按 job_name 升序分页。
+func (s *Server) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	matched := make([]*pb.Job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		switch {
+		case req.AppName != "" && j.AppName != req.AppName,
+			req.Keyword != "" && !strings.Contains(j.JobName, req.Keyword) && !strings.Contains(j.Description, req.Keyword),
+			req.TriggerType != pb.TriggerType_TRIGGER_TYPE_UNSPECIFIED && j.TriggerType != req.TriggerType,
+			req.Status != "" && j.Status != req.Status,
+			req.Priority != pb.Priority_PRIORITY_UNSPECIFIED && j.Priority != req.Priority:
+			continue
+		}
+		matched = append(matched, proto.Clone(j).(*pb.Job))
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].JobName < matched[j].JobName })
+	return &pb.ListJobsResponse{Jobs: pageOf(matched, req.Page, req.PageSize), Total: int64(len(matched))}, nil
+}
+
+// PauseJob 把 job 状态置为 paused；仅 job 所属应用可操作。
+func (s *Server) PauseJob(ctx context.Context, req *pb.PauseJobRequest) (*pb.Job, error) {
+	return s.setJobStatus(ctx, req.JobName, "paused")
+}
+
+// ResumeJob 把 job 状态置为 ready；仅 job 所属应用可操作。
+func (s *Server) ResumeJob(ctx context.Context, req *pb.ResumeJobRequest) (*pb.Job, error) {
+	return s.setJobStatus(ctx, req.JobName, "ready")
+}
+
+func (s *Server) setJobStatus(ctx context.Context, jobName, st string) (*pb.Job, error) {
+	caller, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, err := s.ownedJobLocked(caller, jobName)
+	if err != nil {
+		return nil, err
+	}
+	job.Status = st
+	job.UpdatedAt = time.Now().Unix()
+	s.notifyLocked()
+	return proto.Clone(job).(*pb.Job), nil
+}
+
+// TriggerJob 手动触发一次（trigger_type=manual）；仅 job 所属应用可操作。
+// EXECUTE_MODE_SHARDING 且 shard_total>1 的 job 登记一条父 run 与 shard_total 条分片 run（parent_run_id 指向父 run），
+// 分片全部结束后父 run 汇总为 SUCCESS / FAILED。
+func (s *Server) TriggerJob(ctx context.Context, req *pb.TriggerJobRequest) (*pb.TriggerJobResponse, error) {
+	caller, err := s.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	job, err := s.ownedJobLocked(caller, req.JobName)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	newRun := func() *pb.Run {
+		return &pb.Run{
+			JobName:       job.JobName,
+			AppName:       job.AppName,
+			BizKey:        req.BizKey,
+			Payload:       req.Payload,
+			TriggerType:   pb.TriggerType_TRIGGER_TYPE_MANUAL,
+			TriggerSource: req.Operator,
+		}
+	}
+	if job.ExecuteMode != pb.ExecuteMode_EXECUTE_MODE_SHARDING || job.ShardTotal <= 1 {
+		run := newRun()
+		sess, d := s.newRunLocked(run)
+		s.mu.Unlock()
+		s.sendDispatch(sess, d)
+		return &pb.TriggerJobResponse{RunId: run.RunId}, nil
+	}
+
+	parent := newRun()
+	parent.ShardTotal = job.ShardTotal
+	s.registerRunLocked(parent)
+	parent.Status = pb.RunStatus_RUN_STATUS_RUNNING
+	type pending struct {
+		sess *session
+		d    *pb.Dispatch
+	}
+	dispatches := make([]pending, 0, job.ShardTotal)
+	for i := range job.ShardTotal {
+		run := newRun()
+		run.ParentRunId = parent.RunId
+		run.ShardIndex = i
+		run.ShardTotal = job.ShardTotal
+		sess, d := s.newRunLocked(run)
+		dispatches = append(dispatches, pending{sess, d})
+	}
+	s.mu.Unlock()
+
+	for _, p := range dispatches {
+		s.sendDispatch(p.sess, p.d)
+	}
+	return &pb.TriggerJobResponse{RunId: parent.RunId}, nil
+}
+
+// rollupShardsLocked 分片 run 结束后刷新父 run：全部结束时 SUCCESS（全部成功）或 FAILED，error 记录失败分片数。
+func (s *Server) rollupShardsLocked(shard *pb.Run) {
+	parent, ok := s.runs[shard.ParentRunId]
+	if !ok || shard.ShardTotal == 0 || parent.ShardTotal == 0 {
+		return
+	}
+	finished, failed := 0, 0
+	for _, r := range s.runs {
+		if r.ParentRunId != parent.RunId || r.ShardTotal == 0 || !isTerminal(r.Status) {
+			continue
+		}
+		finished++
+		if r.Status != pb.RunStatus_RUN_STATUS_SUCCESS {
+			failed++
+		}
+	}
+	if finished < int(parent.ShardTotal) {
+		return
+	}
+	parent.EndedAt = time.Now().UnixMilli()
+	parent.Status = pb.RunStatus_RUN_STATUS_SUCCESS
+	if failed > 0 {
+		parent.Status = pb.RunStatus_RUN_STATUS_FAILED
+		parent.Error = fmt.Sprintf("%d/%d shards failed", failed, parent.ShardTotal)
+	}
+}
+
+// ListPendingChanges 按状态过滤待复核变更；status 为空返回全部。
+func (s *Server) ListPendingChanges(ctx context.Context, req *pb.ListPendingChangesRequest) (*pb.ListPendingChangesResponse, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := make([]*pb.PendingChange, 0, len(s.changes))
+	for _, c := range s.changes {
+		if req.Status == "" || c.Status == req.Status {
+			items = append(items, proto.Clone(c).(*pb.PendingChange))
+		}
+	}
+	return &pb.ListPendingChangesResponse{Items: items}, nil
+}
+
+// ApprovePendingChange 通过变更并应用暂存的 job 配置；复核人不能是提交人。
+func (s *Server) ApprovePendingChange(ctx context.Context, req *pb.ApprovePendingChangeRequest) (*pb.ApprovePendingChangeResponse, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, msg := s.reviewableChangeLocked(req.Id, req.Approver)
+	if msg != "" {
+		return &pb.ApprovePendingChangeResponse{Ok: false, Error: msg}, nil
+	}
+	c.Status, c.Approver, c.ApprovedAt = "approved", req.Approver, time.Now().Unix()
+	if job, ok := s.staged[c.Id]; ok {
+		s.jobs[job.JobName] = job
+		delete(s.staged, c.Id)
+	}
+	s.notifyLocked()
+	return &pb.ApprovePendingChangeResponse{Ok: true}, nil
+}
+
+// RejectPendingChange 驳回变更并丢弃暂存配置。
+func (s *Server) RejectPendingChange(ctx context.Context, req *pb.RejectPendingChangeRequest) (*pb.RejectPendingChangeResponse, error) {
+	if _, err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, msg := s.reviewableChangeLocked(req.Id, req.Approver)
+	if msg != "" {
+		return &pb.RejectPendingChangeResponse{Ok: false, Error: msg}, nil
+	}
+	c.Status, c.Approver, c.ApprovedAt = "rejected", req.Approver, time.Now().Unix()
+	delete(s.staged, c.Id)
+	s.notifyLocked()
+	return &pb.RejectPendingChangeResponse{Ok: true}, nil
+}
+
+// reviewableChangeLocked 取状态为 pending 的变更；不可复核时返回原因。调用方须持有 s.mu。
+func (s *Server) reviewableChangeLocked(id int64, approver string) (*pb.PendingChange, string) {
+	for _, c := range s.changes {
+		if c.Id != id {
+			continue
+		}
+		switch {
+		case c.Status != "pending":
+			return nil, "change already " + c.Status
+		case approver == "" || approver == c.Proposer:
+			return nil, "approver must differ from proposer"
+		}
+		return c, ""
+	}
+	return nil, "change not found"
+}
+
+// pageOf 取第 page 页（从 1 开始）；pageSize<=0 时返回全部。
+func pageOf[T any](items []T, page, pageSize int32) []T {
+	if pageSize <= 0 {
+		return items
+	}
+	if page <= 0 {
+		page = 1
+	}
+	start := int(page-1) * int(pageSize)
+	if start >= len(items) {
+		return nil
+	}
+	end := start + int(pageSize)
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+// runSeq 取 "run-N" 的序号用于稳定排序；Dispatch 传入的自定义 run_id 排在最前。
+func runSeq(runID string) int64 {
+	n, err := strconv.ParseInt(strings.TrimPrefix(runID, "run-"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+func isTerminal(st pb.RunStatus) bool {
+	switch st {
+	case pb.RunStatus_RUN_STATUS_SUCCESS, pb.RunStatus_RUN_STATUS_FAILED, pb.RunStatus_RUN_STATUS_TIMEOUT,
+		pb.RunStatus_RUN_STATUS_DEAD, pb.RunStatus_RUN_STATUS_CANCELED:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,660 @@
+// Package schedulertest 提供进程内的 fake iot-scheduler，用于在普通 go test 中驱动 scheduler.Client。
+//
+// Server 监听 127.0.0.1 随机端口，实现 WorkerService.Connect 与 SchedulerService 的
+// SubmitTask / SubmitTasks / GetRun / WatchRun / CancelRun / ListRuns / RetryRun / GetJob / CreateJob（EnsureJob 路径）/ UpdateJob /
+// DeleteJob / ListJobs / PauseJob / ResumeJob / TriggerJob 与双人复核（ListPendingChanges / Approve / Reject），
+// 并按与 SDK sign() 相同的 HMAC-SHA256 算法校验签名，管理方法另认可 Options.AdminToken 作为 JWT。测试可以：
+//   - Dispatch / SendCancel / SendReload 主动向 worker 推送消息
+//   - KillStream 模拟断线，验证重连与结果补报
+//   - FailSubmit 注入 SubmitTask / SubmitTasks 错误，验证本地 buffer / spool 重试
+//   - WaitAck / WaitResult / WaitProgress / Acks / Results / Progress / Heartbeats 断言 worker 上报内容
+//   - 同一 run 重新 Dispatch 或 RetryRun 时回传最近一次 checkpoint，验证断点续跑
+//   - SubmitTask 携带 run_at 时登记为延迟 run，到期后才派发，验证定时提交
+//   - TriggerJob 分片 job 时拆分为父 run + 分片 run，验证 ShardedHandler 与 ShardAggregate
+//   - Options.ClockOffset 模拟服务端时钟偏移，验证 SDK 按 server_ts / x-server-ts 校正签名时间
+//   - 多个 Server 配合 SetServing（grpc.health.v1）/ SetLeader / Close，验证多副本轮询、故障切换与 leader 写路由
+//
+// 典型用法：
+//
+//	srv := schedulertest.NewServer(schedulertest.Options{})
+//	defer srv.Close()
+//	c, _ := scheduler.New(scheduler.Config{
+//	    Endpoint: srv.Addr(), AppName: srv.AppName(), AppKey: srv.AppKey(), AppSecret: srv.AppSecret(),
+//	})
+//	c.RegisterHandler("job", h)
+//	_ = c.Start(ctx)
+//	_ = srv.WaitWorker(ctx)
+//	_ = srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "job"})
+//	res, _ := srv.WaitResult(ctx, "r1")
+package schedulertest
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// 签名时间窗口，与服务端 sigverify 保持一致。
+const signatureWindow = 5 * time.Minute
+
+// ErrNoWorker Dispatch / SendCancel / SendReload 时没有已注册的 worker 连接。
+var ErrNoWorker = errors.New("schedulertest: no worker connected")
+
+// Options 是 NewServer 的参数；零值字段使用默认值。
+type Options struct {
+	// AppName / AppKey / AppSecret 服务端认可的应用凭据；默认 "test-app" / "test-key" / "test-secret"
+	AppName   string
+	AppKey    string
+	AppSecret string
+
+	// AdminToken 认可的 JWT（metadata "authorization: Bearer <token>"），持有者不受 job 归属限制；默认 "test-admin-token"
+	AdminToken string
+
+	// HeartbeatIntervalSec RegisterResponse.heartbeat_interval；默认 1
+	HeartbeatIntervalSec int32
+
+	// Leader RegisterResponse.scheduler_leader 与 unary 响应头 x-scheduler-leader；默认 "schedulertest"，运行中可用 SetLeader 修改
+	Leader string
+
+	// AutoDispatch 为 true 时 SubmitTask 创建的 run 立即（延迟 run 到期时）派发给已连接且注册了该 job 的 worker
+	AutoDispatch bool
+
+	// DisableWatchRun 为 true 时 WatchRun 返回 Unimplemented，模拟老版本服务端（SDK 降级为轮询 GetRun）
+	DisableWatchRun bool
+
+	// DisableSubmitTasks 为 true 时 SubmitTasks 返回 Unimplemented，模拟老版本服务端（SDK 降级为逐条 SubmitTask）
+	DisableSubmitTasks bool
+
+	// ClockOffset 服务端时钟相对本机的偏移：签名时间窗口、RegisterResponse.server_ts 与 unary 响应头 x-server-ts
+	// 均按偏移后的时间计算，用于验证 SDK 的时钟校正
+	ClockOffset time.Duration
+
+	// TLSConfig 非 nil 时以 TLS 提供服务（ClientAuth 设为 RequireAndVerifyClientCert 即 mTLS）；默认明文
+	TLSConfig *tls.Config
+}
+
+// maxSubmitBatch 单次 SubmitTasks 的条目上限。
+const maxSubmitBatch = 500
+
+// Server 进程内 fake scheduler；所有方法并发安全。
+type Server struct {
+	pb.UnimplementedWorkerServiceServer
+	pb.UnimplementedSchedulerServiceServer
+
+	opts    Options
+	lis     net.Listener
+	grpcSrv *grpc.Server
+	health  *health.Server
+
+	mu         sync.Mutex
+	changed    chan struct{} // 任一状态变化时 close 并替换，用于 Wait* 广播
+	leader     string        // RegisterResponse.scheduler_leader，初始为 Options.Leader
+	sessions   []*session
+	registers  []*pb.RegisterRequest
+	heartbeats []*pb.Heartbeat
+	acks       []*pb.JobAck
+	results    []*pb.JobResult
+	progress   []*pb.JobProgress
+	checkpoint map[string][]byte // run_id → 最近一次非空 checkpoint
+	runs       map[string]*pb.Run
+	jobs       map[string]*pb.Job
+	changes    []*pb.PendingChange
+	staged     map[int64]*pb.Job // pending change id → 复核通过后生效的 job 配置
+	changeSeq  int64
+	dedup      map[string]dedupEntry
+	submitErr  error
+	seq        int64
+
+	batchSubmits int // 成功进入处理的 SubmitTasks 调用次数
+}
+
+type dedupEntry struct {
+	runID     string
+	expiresAt time.Time
+}
+
+// session 一条 Connect 流；send 需加锁，grpc ServerStream.Send 不支持并发调用。
+type session struct {
+	workerID    string
+	handlerJobs map[string]bool
+	stream      pb.WorkerService_ConnectServer
+	sendMu      sync.Mutex
+	kill        chan struct{}
+	killOnce    sync.Once
+	draining    bool // RegisterRequest / Heartbeat 声明 draining 后不再被 AutoDispatch 选中；受 Server.mu 保护
+}
+
+func (s *session) send(msg *pb.SchedulerMessage) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.stream.Send(msg)
+}
+
+// NewServer 启动 fake scheduler；监听失败直接 panic（测试环境下属不可恢复错误）。
+func NewServer(opts Options) *Server {
+	if opts.AppName == "" {
+		opts.AppName = "test-app"
+	}
+	if opts.AppKey == "" {
+		opts.AppKey = "test-key"
+	}
+	if opts.AppSecret == "" {
+		opts.AppSecret = "test-secret"
+	}
+	if opts.AdminToken == "" {
+		opts.AdminToken = "test-admin-token"
+	}
+	if opts.HeartbeatIntervalSec <= 0 {
+		opts.HeartbeatIntervalSec = 1
+	}
+	if opts.Leader == "" {
+		opts.Leader = "schedulertest"
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("schedulertest: listen: %v", err))
+	}
+	s := &Server{
+		opts:       opts,
+		lis:        lis,
+		health:     health.NewServer(),
+		leader:     opts.Leader,
+		changed:    make(chan struct{}),
+		runs:       make(map[string]*pb.Run),
+		jobs:       make(map[string]*pb.Job),
+		staged:     make(map[int64]*pb.Job),
+		dedup:      make(map[string]dedupEntry),
+		checkpoint: make(map[string][]byte),
+	}
+	serverOpts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(s.headerInterceptor)}
+	if opts.TLSConfig != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(opts.TLSConfig)))
+	}
+	s.grpcSrv = grpc.NewServer(serverOpts...)
+	pb.RegisterWorkerServiceServer(s.grpcSrv, s)
+	pb.RegisterSchedulerServiceServer(s.grpcSrv, s)
+	healthpb.RegisterHealthServer(s.grpcSrv, s.health)
+	go func() { _ = s.grpcSrv.Serve(lis) }()
+	return s
+}
+
+// Addr 监听地址，直接填入 scheduler.Config.Endpoint。
+func (s *Server) Addr() string { return s.lis.Addr().String() }
+
+// AppName / AppKey / AppSecret 服务端认可的应用凭据。
+func (s *Server) AppName() string   { return s.opts.AppName }
+func (s *Server) AppKey() string    { return s.opts.AppKey }
+func (s *Server) AppSecret() string { return s.opts.AppSecret }
+
+// AdminToken 认可的 JWT，填入 scheduler.Config.AdminToken。
+func (s *Server) AdminToken() string { return s.opts.AdminToken }
+
+// SetLeader 修改之后 RegisterResponse.scheduler_leader 与响应头 x-scheduler-leader 的取值，用于验证 SDK 的 leader 写路由。
+func (s *Server) SetLeader(leader string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leader = leader
+}
+
+// SetServing 切换 grpc.health.v1 健康状态（默认 SERVING）；NOT_SERVING 时多副本 Client 不再向本副本发 unary 调用。
+func (s *Server) SetServing(serving bool) {
+	if serving {
+		s.health.Resume()
+	} else {
+		s.health.Shutdown()
+	}
+}
+
+// Close 断开所有连接并停止服务。
+func (s *Server) Close() {
+	s.KillStream()
+	s.grpcSrv.Stop()
+}
+
+// notifyLocked 唤醒所有 Wait*；调用方须持有 s.mu。
+func (s *Server) notifyLocked() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// waitFor 阻塞直到 cond 返回 true 或 ctx 结束；cond 在持有 s.mu 时调用。
+func (s *Server) waitFor(ctx context.Context, cond func() bool) error {
+	for {
+		s.mu.Lock()
+		if cond() {
+			s.mu.Unlock()
+			return nil
+		}
+		ch := s.changed
+		s.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// now 服务端当前时间（含 Options.ClockOffset）。
+func (s *Server) now() time.Time { return time.Now().Add(s.opts.ClockOffset) }
+
+// headerInterceptor 所有 unary 响应（含鉴权失败）在 header 中携带 x-server-ts 供 SDK 校正时钟，
+// 并携带 x-scheduler-leader（当前 leader 非空时）供 AdminClient 发现 leader。
+func (s *Server) headerInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md := metadata.Pairs("x-server-ts", strconv.FormatInt(s.now().Unix(), 10))
+	if leader := s.currentLeader(); leader != "" {
+		md.Set("x-scheduler-leader", leader)
+	}
+	_ = grpc.SetHeader(ctx, md)
+	return handler(ctx, req)
+}
+
+// verify 按 SDK sign() 的算法复算签名：hex( HMAC-SHA256(appSecret, appKey + nonce + ts) )，并校验 5min 时间窗口。
+func (s *Server) verify(appName, appKey, signature, nonce string, ts int64) error {
+	if appName != s.opts.AppName || appKey != s.opts.AppKey {
+		return errors.New("unknown app")
+	}
+	if d := s.now().Sub(time.Unix(ts, 0)); d > signatureWindow || d < -signatureWindow {
+		return errors.New("timestamp out of window")
+	}
+	mac := hmac.New(sha256.New, []byte(s.opts.AppSecret))
+	mac.Write([]byte(appKey))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte(strconv.FormatInt(ts, 10)))
+	if !hmac.Equal([]byte(hex.EncodeToString(mac.Sum(nil))), []byte(signature)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+// ===== WorkerService =====
+
+// Connect 实现 WorkerService.Connect：校验首条 RegisterRequest 后持续接收 Heartbeat / Ack / Result。
+func (s *Server) Connect(stream pb.WorkerService_ConnectServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	reg := first.GetRegister()
+	if reg == nil {
+		return status.Error(codes.InvalidArgument, "first message must be RegisterRequest")
+	}
+	if err := s.verify(reg.AppName, reg.AppKey, reg.Signature, reg.Nonce, reg.Ts); err != nil {
+		_ = stream.Send(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Register{
+			Register: &pb.RegisterResponse{Ok: false, Error: err.Error(), ServerTs: s.now().Unix()},
+		}})
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	sess := &session{
+		workerID:    reg.WorkerId,
+		handlerJobs: make(map[string]bool, len(reg.HandlerJobs)),
+		stream:      stream,
+		kill:        make(chan struct{}),
+		draining:    reg.Draining,
+	}
+	for _, j := range reg.HandlerJobs {
+		sess.handlerJobs[j] = true
+	}
+	if err := sess.send(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Register{
+		Register: &pb.RegisterResponse{
+			Ok:                true,
+			ServerTs:          s.now().Unix(),
+			HeartbeatInterval: s.opts.HeartbeatIntervalSec,
+			SchedulerLeader:   s.currentLeader(),
+		},
+	}}); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.registers = append(s.registers, reg)
+	s.sessions = append(s.sessions, sess)
+	s.notifyLocked()
+	s.mu.Unlock()
+	defer s.removeSession(sess)
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			s.onWorkerMessage(sess, msg)
+		}
+	}()
+	select {
+	case err := <-recvErr:
+		return err
+	case <-sess.kill:
+		return status.Error(codes.Unavailable, "schedulertest: stream killed")
+	}
+}
+
+func (s *Server) currentLeader() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.leader
+}
+
+func (s *Server) removeSession(sess *session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, cur := range s.sessions {
+		if cur == sess {
+			s.sessions = append(s.sessions[:i], s.sessions[i+1:]...)
+			break
+		}
+	}
+	s.notifyLocked()
+}
+
+func (s *Server) onWorkerMessage(sess *session, msg *pb.WorkerMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch p := msg.Payload.(type) {
+	case *pb.WorkerMessage_Heartbeat:
+		s.heartbeats = append(s.heartbeats, p.Heartbeat)
+		if p.Heartbeat.Draining {
+			sess.draining = true
+		}
+	case *pb.WorkerMessage_Ack:
+		s.acks = append(s.acks, p.Ack)
+		if run, ok := s.runs[p.Ack.RunId]; ok {
+			if p.Ack.Accepted {
+				run.Status = pb.RunStatus_RUN_STATUS_RUNNING
+			} else {
+				run.Status = pb.RunStatus_RUN_STATUS_DISPATCH_FAIL
+				run.Error = p.Ack.Reason
+			}
+		}
+	case *pb.WorkerMessage_Result:
+		r := p.Result
+		s.results = append(s.results, r)
+		if run, ok := s.runs[r.RunId]; ok {
+			run.Status = r.Status
+			if r.NonRetriable && r.Status == pb.RunStatus_RUN_STATUS_FAILED {
+				// 与服务端一致：永久失败不再重试，直接转 DEAD
+				run.Status = pb.RunStatus_RUN_STATUS_DEAD
+			}
+			run.Output = []byte(r.Output)
+			run.Error = r.Error
+			run.DurationMs = r.DurationMs
+			run.StartedAt = r.StartedAt
+			run.EndedAt = r.EndedAt
+			s.rollupShardsLocked(run)
+		}
+	case *pb.WorkerMessage_Progress:
+		pg := p.Progress
+		s.progress = append(s.progress, pg)
+		if len(pg.Checkpoint) > 0 {
+			s.checkpoint[pg.RunId] = pg.Checkpoint
+		}
+		if run, ok := s.runs[pg.RunId]; ok {
+			run.ProgressPercent = pg.Percent
+			run.ProgressMessage = pg.Message
+			run.ProgressAt = pg.Ts
+		}
+	}
+	s.notifyLocked()
+}
+
+// ===== 测试控制面 =====
+
+// WaitWorker 阻塞直到至少有一个 worker 完成注册。
+func (s *Server) WaitWorker(ctx context.Context) error {
+	return s.waitFor(ctx, func() bool { return len(s.sessions) > 0 })
+}
+
+// WaitRegisters 阻塞直到累计收到至少 n 次成功注册（可用于断言重连次数）。
+func (s *Server) WaitRegisters(ctx context.Context, n int) error {
+	return s.waitFor(ctx, func() bool { return len(s.registers) >= n })
+}
+
+// latestSessionLocked 返回最近注册的 session；调用方须持有 s.mu。
+func (s *Server) latestSessionLocked() *session {
+	if len(s.sessions) == 0 {
+		return nil
+	}
+	return s.sessions[len(s.sessions)-1]
+}
+
+func (s *Server) sendToLatest(msg *pb.SchedulerMessage) error {
+	s.mu.Lock()
+	sess := s.latestSessionLocked()
+	s.mu.Unlock()
+	if sess == nil {
+		return ErrNoWorker
+	}
+	return sess.send(msg)
+}
+
+// Dispatch 向最近注册的 worker 派发一条任务；无 worker 时返回 ErrNoWorker。RunId 为空时自动生成；DispatchedAt 为空时取当前时间；
+// Checkpoint 为空时回传该 run 最近一次上报的 checkpoint（模拟服务端重试）。
+// 派发的 run 会登记到服务端，可通过 GetRun 查询。
+func (s *Server) Dispatch(d *pb.Dispatch) error {
+	s.mu.Lock()
+	sess := s.latestSessionLocked()
+	if sess == nil {
+		s.mu.Unlock()
+		return ErrNoWorker
+	}
+	if d.RunId == "" {
+		d.RunId = s.nextRunIDLocked()
+	}
+	if d.DispatchedAt == 0 {
+		d.DispatchedAt = time.Now().Unix()
+	}
+	if len(d.Checkpoint) == 0 {
+		d.Checkpoint = s.checkpoint[d.RunId]
+	}
+	run, ok := s.runs[d.RunId]
+	if !ok {
+		run = &pb.Run{
+			RunId:       d.RunId,
+			JobName:     d.JobName,
+			AppName:     s.opts.AppName,
+			BizKey:      d.BizKey,
+			Payload:     d.Payload,
+			TriggerType: d.TriggerType,
+			TraceId:     d.TraceId,
+			SpanId:      d.SpanId,
+			CreatedAt:   time.Now().Unix(),
+		}
+		s.runs[d.RunId] = run
+	}
+	run.Status = pb.RunStatus_RUN_STATUS_DISPATCHED
+	run.RetryCount = d.RetryCount
+	run.DispatchedAt = time.Now().UnixMilli()
+	s.notifyLocked()
+	s.mu.Unlock()
+	return sess.send(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Dispatch{Dispatch: d}})
+}
+
+// SendCancel 向最近注册的 worker 推送 Cancel。
+func (s *Server) SendCancel(runID, reason string) error {
+	return s.sendToLatest(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Cancel{
+		Cancel: &pb.Cancel{RunId: runID, Reason: reason},
+	}})
+}
+
+// SendReload 向最近注册的 worker 推送 Reload。
+func (s *Server) SendReload(term int64, jobNames ...string) error {
+	return s.sendToLatest(&pb.SchedulerMessage{Payload: &pb.SchedulerMessage_Reload{
+		Reload: &pb.Reload{JobNames: jobNames, Term: term},
+	}})
+}
+
+// KillStream 以 codes.Unavailable 结束当前所有 Connect 流，模拟网络断开；SDK 应自动重连。
+func (s *Server) KillStream() {
+	s.mu.Lock()
+	sessions := append([]*session(nil), s.sessions...)
+	s.mu.Unlock()
+	for _, sess := range sessions {
+		sess.killOnce.Do(func() { close(sess.kill) })
+	}
+}
+
+// FailSubmit 让后续 SubmitTask 返回 err（如 status.Error(codes.Unavailable, "...")）；传 nil 恢复正常。
+func (s *Server) FailSubmit(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.submitErr = err
+}
+
+// WaitAck 阻塞直到收到 runID 的 JobAck。
+func (s *Server) WaitAck(ctx context.Context, runID string) (*pb.JobAck, error) {
+	var got *pb.JobAck
+	err := s.waitFor(ctx, func() bool {
+		for _, a := range s.acks {
+			if a.RunId == runID {
+				got = a
+				return true
+			}
+		}
+		return false
+	})
+	return got, err
+}
+
+// WaitResult 阻塞直到收到 runID 的 JobResult，返回最近一条。
+func (s *Server) WaitResult(ctx context.Context, runID string) (*pb.JobResult, error) {
+	var got *pb.JobResult
+	err := s.waitFor(ctx, func() bool {
+		for i := len(s.results) - 1; i >= 0; i-- {
+			if s.results[i].RunId == runID {
+				got = s.results[i]
+				return true
+			}
+		}
+		return false
+	})
+	return got, err
+}
+
+// WaitProgress 阻塞直到收到 runID 进度不低于 pct 的 JobProgress，返回该条。
+func (s *Server) WaitProgress(ctx context.Context, runID string, pct int32) (*pb.JobProgress, error) {
+	var got *pb.JobProgress
+	err := s.waitFor(ctx, func() bool {
+		for _, p := range s.progress {
+			if p.RunId == runID && p.Percent >= pct {
+				got = p
+				return true
+			}
+		}
+		return false
+	})
+	return got, err
+}
+
+// WaitDraining 阻塞直到最近注册的 worker 声明 draining（Client.Drain）。
+func (s *Server) WaitDraining(ctx context.Context) error {
+	return s.waitFor(ctx, func() bool {
+		sess := s.latestSessionLocked()
+		return sess != nil && sess.draining
+	})
+}
+
+// WaitHeartbeats 阻塞直到累计收到至少 n 条 Heartbeat。
+func (s *Server) WaitHeartbeats(ctx context.Context, n int) error {
+	return s.waitFor(ctx, func() bool { return len(s.heartbeats) >= n })
+}
+
+// Registers 返回收到的全部成功 RegisterRequest 副本。
+func (s *Server) Registers() []*pb.RegisterRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*pb.RegisterRequest(nil), s.registers...)
+}
+
+// Acks 返回收到的全部 JobAck 副本。
+func (s *Server) Acks() []*pb.JobAck {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*pb.JobAck(nil), s.acks...)
+}
+
+// Results 返回收到的全部 JobResult 副本（含重复上报）。
+func (s *Server) Results() []*pb.JobResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*pb.JobResult(nil), s.results...)
+}
+
+// Progress 返回收到的全部 JobProgress 副本（SDK 合并后的上报）。
+func (s *Server) Progress() []*pb.JobProgress {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*pb.JobProgress(nil), s.progress...)
+}
+
+// BatchSubmits 返回已处理的 SubmitTasks 调用次数（不含 FailSubmit 拒绝的调用），用于断言 SDK 走了批量接口。
+func (s *Server) BatchSubmits() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.batchSubmits
+}
+
+// Heartbeats 返回收到的全部 Heartbeat 副本。
+func (s *Server) Heartbeats() []*pb.Heartbeat {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*pb.Heartbeat(nil), s.heartbeats...)
+}
+
+// Runs 返回服务端登记的全部 run（SubmitTask 或 Dispatch 产生）。
+func (s *Server) Runs() []*pb.Run {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*pb.Run, 0, len(s.runs))
+	for _, r := range s.runs {
+		out = append(out, proto.Clone(r).(*pb.Run))
+	}
+	return out
+}
+
+// Jobs 返回通过 CreateJob 注册的全部 job。
+func (s *Server) Jobs() []*pb.Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*pb.Job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		out = append(out, proto.Clone(j).(*pb.Job))
+	}
+	return out
+}
+
+// PendingChanges 返回 UpdateJob 登记的全部待复核变更（含已处理）。
+func (s *Server) PendingChanges() []*pb.PendingChange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*pb.PendingChange, 0, len(s.changes))
+	for _, c := range s.changes {
+		out = append(out, proto.Clone(c).(*pb.PendingChange))
+	}
+	return out
+}
+
+func (s *Server) nextRunIDLocked() string {
+	s.seq++
+	return fmt.Sprintf("run-%d", s.seq)
+}
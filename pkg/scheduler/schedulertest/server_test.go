@@ -0,0 +1,78 @@
+package schedulertest
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func signFor(secret, appKey, nonce string, ts int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(appKey + nonce + strconv.FormatInt(ts, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestServer_SubmitTaskVerifiesSignature(t *testing.T) {
+	srv := NewServer(Options{})
+	defer srv.Close()
+
+	ts := time.Now().Unix()
+	req := &pb.SubmitTaskRequest{
+		AppName: srv.AppName(), AppKey: srv.AppKey(), Nonce: "n1", Ts: ts, JobName: "j",
+		Signature: signFor("wrong-secret", srv.AppKey(), "n1", ts),
+	}
+	if _, err := srv.SubmitTask(context.Background(), req); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("bad signature err=%v", err)
+	}
+
+	req.Signature = signFor(srv.AppSecret(), srv.AppKey(), "n1", ts)
+	resp, err := srv.SubmitTask(context.Background(), req)
+	if err != nil || resp.RunId == "" {
+		t.Fatalf("valid signature resp=%v err=%v", resp, err)
+	}
+
+	stale := ts - int64(10*time.Minute/time.Second)
+	req.Ts, req.Signature = stale, signFor(srv.AppSecret(), srv.AppKey(), "n1", stale)
+	if _, err := srv.SubmitTask(context.Background(), req); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("stale ts err=%v", err)
+	}
+}
+
+func TestServer_CancelRunLifecycle(t *testing.T) {
+	srv := NewServer(Options{})
+	defer srv.Close()
+
+	if _, err := srv.CancelRun(context.Background(), &pb.CancelRunRequest{RunId: "nope"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("unknown run err=%v", err)
+	}
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r0", JobName: "j"}); err != ErrNoWorker {
+		t.Fatalf("dispatch without worker err=%v", err)
+	}
+	ts := time.Now().Unix()
+	sub, err := srv.SubmitTask(context.Background(), &pb.SubmitTaskRequest{
+		AppName: srv.AppName(), AppKey: srv.AppKey(), Nonce: "n1", Ts: ts, JobName: "j",
+		Signature: signFor(srv.AppSecret(), srv.AppKey(), "n1", ts),
+	})
+	if err != nil {
+		t.Fatalf("SubmitTask: %v", err)
+	}
+	resp, err := srv.CancelRun(context.Background(), &pb.CancelRunRequest{RunId: sub.RunId, Reason: "stop"})
+	if err != nil || !resp.Ok {
+		t.Fatalf("cancel resp=%v err=%v", resp, err)
+	}
+	run, _ := srv.GetRun(context.Background(), &pb.GetRunRequest{RunId: sub.RunId})
+	if run.Status != pb.RunStatus_RUN_STATUS_CANCELED {
+		t.Fatalf("run status got=%s", run.Status)
+	}
+	if resp, _ := srv.CancelRun(context.Background(), &pb.CancelRunRequest{RunId: sub.RunId}); resp.Ok {
+		t.Fatal("cancel of finished run should report not ok")
+	}
+}
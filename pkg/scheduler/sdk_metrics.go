@@ -0,0 +1,192 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sidchai/compkg/pkg/logger"
+	"github.com/sidchai/compkg/pkg/metrics"
+)
+
+// 派发准入结果，scheduler_sdk_dispatch_total 的 status 标签。
+const (
+	dispatchAccepted = "accepted"
+	dispatchQueued   = "queued"
+	dispatchRejected = "rejected"
+)
+
+// 丢弃的出站消息类型，scheduler_sdk_dropped_total 的 type 标签。
+const (
+	dropAck    = "ack"    // session 结束前未送出的 JobAck（服务端会重新派发）
+	dropResult = "result" // 结果发件箱已满被淘汰的 JobResult（由服务端 timeout 兜底）
+	dropTask   = "task"   // 本地 buffer / spool 无法接纳、被淘汰、记录损坏或重放时被服务端拒绝的 EnqueueTask 任务
+)
+
+// sdkMetrics Client 的 Prometheus 指标（prometheus.Collector）：派发与 handler 耗时按 job 计数，
+// 连接状态、队列深度、丢弃数等在采集时从 Client 读取。所有指标带常量标签 app。
+//
+// 即使未注册也照常记录（只是无人采集），调用方无需判空。
+type sdkMetrics struct {
+	c *Client
+
+	dispatches     *prometheus.CounterVec
+	handlerSeconds *prometheus.HistogramVec
+
+	connected      *prometheus.Desc
+	disconnected   *prometheus.Desc
+	reconnects     *prometheus.Desc
+	dropped        *prometheus.Desc
+	inflight       *prometheus.Desc
+	queued         *prometheus.Desc
+	pendingResults *prometheus.Desc
+	buffered       *prometheus.Desc
+	spilled        *prometheus.Desc
+	spilledBytes   *prometheus.Desc
+	clockOffset    *prometheus.Desc
+}
+
+func newSDKMetrics(c *Client) *sdkMetrics {
+	app := prometheus.Labels{"app": c.cfg.AppName}
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc("scheduler_sdk_"+name, help, labels, app)
+	}
+	return &sdkMetrics{
+		c: c,
+		dispatches: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "scheduler_sdk_dispatch_total",
+			Help:        "Dispatches received from scheduler by admission result (accepted / queued / rejected).",
+			ConstLabels: app,
+		}, []string{"job", "status"}),
+		handlerSeconds: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:        "scheduler_sdk_handler_duration_seconds",
+			Help:        "Handler execution time by job and run outcome (success / failed / timeout / canceled).",
+			ConstLabels: app,
+			Buckets:     prometheus.ExponentialBuckets(0.01, 4, 9), // 10ms ~ 11min
+		}, []string{"job", "status"}),
+		connected:      desc("connected", "1 when the worker stream is registered with scheduler, else 0."),
+		disconnected:   desc("disconnected_seconds", "Seconds since the worker stream was lost (or since Start if never connected); 0 while connected."),
+		reconnects:     desc("reconnects_total", "Worker stream reconnect attempts after a session ended or failed to establish."),
+		dropped:        desc("dropped_total", "Outbound messages dropped by the SDK (ack / result / task).", "type"),
+		inflight:       desc("inflight", "Runs currently executing."),
+		queued:         desc("queued", "Runs accepted and waiting in local job queues."),
+		pendingResults: desc("pending_results", "JobResults not yet confirmed by scheduler (result outbox)."),
+		buffered:       desc("buffered_tasks", "EnqueueTask tasks waiting in the in-memory local buffer."),
+		spilled:        desc("spilled_tasks", "EnqueueTask tasks spilled to the disk spool."),
+		spilledBytes:   desc("spilled_bytes", "Bytes used by the disk spool."),
+		clockOffset:    desc("clock_offset_seconds", "Learned scheduler clock minus local clock."),
+	}
+}
+
+// dispatch 记录一次派发的准入结果。
+func (m *sdkMetrics) dispatch(job, status string) {
+	m.dispatches.WithLabelValues(job, status).Inc()
+}
+
+// handled 记录一次 handler 执行的耗时与结果。
+func (m *sdkMetrics) handled(job, outcome string, d time.Duration) {
+	m.handlerSeconds.WithLabelValues(job, outcome).Observe(d.Seconds())
+}
+
+// Describe 实现 prometheus.Collector。
+func (m *sdkMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.dispatches.Describe(ch)
+	m.handlerSeconds.Describe(ch)
+	for _, d := range []*prometheus.Desc{
+		m.connected, m.disconnected, m.reconnects, m.dropped, m.inflight, m.queued,
+		m.pendingResults, m.buffered, m.spilled, m.spilledBytes, m.clockOffset,
+	} {
+		ch <- d
+	}
+}
+
+// Collect 实现 prometheus.Collector。
+func (m *sdkMetrics) Collect(ch chan<- prometheus.Metric) {
+	c := m.c
+	m.dispatches.Collect(ch)
+	m.handlerSeconds.Collect(ch)
+
+	up, since := c.sessionState()
+	connected, down := 0.0, time.Since(since).Seconds()
+	if up {
+		connected, down = 1, 0
+	}
+	running, queued := c.runQueue.stats()
+	droppedTasks := c.droppedTasks.Load()
+	if c.localBuffer != nil {
+		droppedTasks += c.localBuffer.dropped.Load()
+	}
+	if c.localSpool != nil {
+		droppedTasks += c.localSpool.evicted.Load() + c.localSpool.corrupted.Load()
+	}
+	gauge := func(d *prometheus.Desc, v float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.GaugeValue, v, labels...)
+	}
+	counter := func(d *prometheus.Desc, v int64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(d, prometheus.CounterValue, float64(v), labels...)
+	}
+	gauge(m.connected, connected)
+	gauge(m.disconnected, down)
+	counter(m.reconnects, c.reconnects.Load())
+	counter(m.dropped, c.droppedAcks.Load(), dropAck)
+	counter(m.dropped, c.resultOutbox.dropped.Load(), dropResult)
+	counter(m.dropped, droppedTasks, dropTask)
+	gauge(m.inflight, float64(running))
+	gauge(m.queued, float64(queued))
+	gauge(m.pendingResults, float64(c.PendingResults()))
+	gauge(m.buffered, float64(c.BufferedCount()))
+	gauge(m.spilled, float64(c.SpilledCount()))
+	gauge(m.spilledBytes, float64(c.SpilledBytes()))
+	gauge(m.clockOffset, c.ClockOffset().Seconds())
+}
+
+// metricsRegisterer 按 Config 选择注册指标的 Registerer；未开启返回 nil。
+func (c *Config) metricsRegisterer() prometheus.Registerer {
+	switch {
+	case c.MetricsRegisterer != nil:
+		return c.MetricsRegisterer
+	case !c.MetricsEnabled:
+		return nil
+	case metrics.GetPrometheusRegistry() != nil:
+		return metrics.GetPrometheusRegistry()
+	default:
+		return prometheus.DefaultRegisterer
+	}
+}
+
+// registerMetrics Start 时注册指标；失败（如同一 registry 上已有同 app 的 Client）只记 warn，不影响启动。
+func (c *Client) registerMetrics() {
+	reg := c.cfg.metricsRegisterer()
+	if reg == nil {
+		return
+	}
+	if err := reg.Register(c.metrics); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			logger.Warnf("[scheduler-sdk] metrics for app=%s already registered, skip", c.cfg.AppName)
+		} else {
+			logger.Warnf("[scheduler-sdk] register metrics: %v", err)
+		}
+		return
+	}
+	c.metricsReg = reg
+}
+
+// unregisterMetrics Stop 时注销，之后可用同一 registry 重新 Start 新的 Client。
+func (c *Client) unregisterMetrics() {
+	if c.metricsReg != nil {
+		c.metricsReg.Unregister(c.metrics)
+		c.metricsReg = nil
+	}
+}
+
+// setSessionState 记录 worker stream 连接状态变化。
+func (c *Client) setSessionState(up bool) {
+	c.sessionUp.Store(up)
+	c.sessionSince.Store(time.Now().UnixNano())
+}
+
+// sessionState 当前是否已注册，以及进入该状态的时间。
+func (c *Client) sessionState() (up bool, since time.Time) {
+	return c.sessionUp.Load(), time.Unix(0, c.sessionSince.Load())
+}
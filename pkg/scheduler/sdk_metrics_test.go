@@ -0,0 +1,195 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// gatherValue 从 reg 读取 name 在 labels 下的值：counter / gauge 取值，histogram 取样本数；不存在返回 -1。
+func gatherValue(t *testing.T, reg prometheus.Gatherer, name string, labels map[string]string) float64 {
+	t.Helper()
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+	next:
+		for _, m := range mf.GetMetric() {
+			got := map[string]string{}
+			for _, lp := range m.GetLabel() {
+				got[lp.GetName()] = lp.GetValue()
+			}
+			for k, v := range labels {
+				if got[k] != v {
+					continue next
+				}
+			}
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				return m.GetCounter().GetValue()
+			case dto.MetricType_GAUGE:
+				return m.GetGauge().GetValue()
+			case dto.MetricType_HISTOGRAM:
+				return float64(m.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return -1
+}
+
+func TestMetrics_DispatchSessionAndUnregister(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	srv, c := startFakeClient(t, schedulertest.Options{}, func(cfg *Config) { cfg.MetricsRegisterer = reg }, map[string]HandlerFunc{
+		"echo": func(_ context.Context, job *Job) (string, error) { return string(job.Payload), nil },
+		"fail": func(context.Context, *Job) (string, error) { return "", errors.New("boom") },
+	})
+	ctx := waitCtx(t)
+	app := srv.AppName()
+
+	for _, d := range []*pb.Dispatch{
+		{RunId: "r1", JobName: "echo"},
+		{RunId: "r2", JobName: "fail"},
+		{RunId: "r3", JobName: "unknown"},
+	} {
+		if err := srv.Dispatch(d); err != nil {
+			t.Fatalf("Dispatch: %v", err)
+		}
+		if _, err := srv.WaitAck(ctx, d.RunId); err != nil {
+			t.Fatalf("WaitAck %s: %v", d.RunId, err)
+		}
+	}
+	for _, id := range []string{"r1", "r2"} {
+		if _, err := srv.WaitResult(ctx, id); err != nil {
+			t.Fatalf("WaitResult %s: %v", id, err)
+		}
+	}
+
+	checks := []struct {
+		name   string
+		labels map[string]string
+		want   float64
+	}{
+		{"scheduler_sdk_dispatch_total", map[string]string{"app": app, "job": "echo", "status": dispatchAccepted}, 1},
+		{"scheduler_sdk_dispatch_total", map[string]string{"job": "unknown", "status": dispatchRejected}, 1},
+		{"scheduler_sdk_handler_duration_seconds", map[string]string{"job": "echo", "status": "success"}, 1},
+		{"scheduler_sdk_handler_duration_seconds", map[string]string{"job": "fail", "status": "failed"}, 1},
+		{"scheduler_sdk_connected", map[string]string{"app": app}, 1},
+		{"scheduler_sdk_disconnected_seconds", nil, 0},
+		{"scheduler_sdk_inflight", nil, 0},
+		{"scheduler_sdk_dropped_total", map[string]string{"type": dropAck}, 0},
+	}
+	for _, ck := range checks {
+		if got := gatherValue(t, reg, ck.name, ck.labels); got != ck.want {
+			t.Errorf("%s%v=%v want %v", ck.name, ck.labels, got, ck.want)
+		}
+	}
+
+	// 断流后重连：reconnects_total 增加，重新注册后恢复 connected=1
+	srv.KillStream()
+	if err := srv.WaitRegisters(ctx, 2); err != nil {
+		t.Fatalf("WaitRegisters: %v", err)
+	}
+	if got := gatherValue(t, reg, "scheduler_sdk_reconnects_total", nil); got < 1 {
+		t.Fatalf("reconnects_total=%v, want >= 1", got)
+	}
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Stop(stopCtx); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+	for _, mf := range mfs {
+		if strings.HasPrefix(mf.GetName(), "scheduler_sdk_") {
+			t.Fatalf("%s still registered after Stop", mf.GetName())
+		}
+	}
+}
+
+func TestMetrics_DroppedAndBufferGauges(t *testing.T) {
+	c, err := New(Config{
+		Endpoint: "127.0.0.1:1", AppName: "app", AppKey: "ak", AppSecret: "sk",
+		ResultOutboxCapacity: 1,
+		LocalBufferEnabled:   true,
+		LocalBufferCapacity:  1,
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(c.metrics)
+
+	c.resultOutbox.put(&pb.JobResult{RunId: "r1"}, nil)
+	c.resultOutbox.put(&pb.JobResult{RunId: "r2"}, nil)
+	ctx := context.Background()
+	if _, err := c.EnqueueTask(ctx, SubmitOptions{JobName: "j"}); err != nil {
+		t.Fatalf("EnqueueTask: %v", err)
+	}
+	if _, err := c.EnqueueTask(ctx, SubmitOptions{JobName: "j"}); !errors.Is(err, ErrLocalBufferFull) {
+		t.Fatalf("err=%v, want ErrLocalBufferFull", err)
+	}
+
+	checks := map[string]struct {
+		labels map[string]string
+		want   float64
+	}{
+		"scheduler_sdk_dropped_total":        {map[string]string{"type": dropResult}, 1},
+		"scheduler_sdk_pending_results":      {nil, 1},
+		"scheduler_sdk_buffered_tasks":       {nil, 1},
+		"scheduler_sdk_connected":            {nil, 0},
+		"scheduler_sdk_spilled_tasks":        {nil, 0},
+		"scheduler_sdk_clock_offset_seconds": {nil, 0},
+	}
+	for name, ck := range checks {
+		if got := gatherValue(t, reg, name, ck.labels); got != ck.want {
+			t.Errorf("%s%v=%v want %v", name, ck.labels, got, ck.want)
+		}
+	}
+	if got := gatherValue(t, reg, "scheduler_sdk_dropped_total", map[string]string{"type": dropTask}); got != 1 {
+		t.Errorf("dropped task=%v want 1", got)
+	}
+}
+
+func TestMetrics_Registerer(t *testing.T) {
+	if (&Config{}).metricsRegisterer() != nil {
+		t.Fatal("metrics must not be registered by default")
+	}
+	if (&Config{MetricsEnabled: true}).metricsRegisterer() != prometheus.DefaultRegisterer {
+		t.Fatal("MetricsEnabled without pkg/metrics must fall back to DefaultRegisterer")
+	}
+
+	// 同一 registry 上同 app 的第二个 Client 注册失败只记 warn，不影响第一个
+	reg := prometheus.NewRegistry()
+	cfg := Config{Endpoint: "127.0.0.1:1", AppName: "app", AppKey: "ak", AppSecret: "sk", MetricsRegisterer: reg}
+	a, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	b, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	a.registerMetrics()
+	b.registerMetrics()
+	if a.metricsReg == nil || b.metricsReg != nil {
+		t.Fatalf("registered a=%v b=%v, want only a", a.metricsReg != nil, b.metricsReg != nil)
+	}
+	b.unregisterMetrics()
+	if got := gatherValue(t, reg, "scheduler_sdk_connected", nil); got != 0 {
+		t.Fatalf("first client's metrics lost: connected=%v", got)
+	}
+}
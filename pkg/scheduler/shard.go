@@ -0,0 +1,175 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"sort"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+// shardMaxErrors ShardResult.Errors 最多保留的错误条数。
+const shardMaxErrors = 10
+
+// OwnsKey 判断 key 是否归本分片处理：crc32.ChecksumIEEE(key) % ShardTotal == ShardIndex，
+// 与 pkg/sharding 按 deviceNo 路由子表的算法一致：ShardTotal 整除子表数时，同一子表的 key 全部落在同一分片。
+// 非分片执行（ShardTotal <= 1）时总是返回 true。
+func (j *Job) OwnsKey(key string) bool {
+	if j.ShardTotal <= 1 {
+		return true
+	}
+	return crc32.ChecksumIEEE([]byte(key))%uint32(j.ShardTotal) == uint32(j.ShardIndex)
+}
+
+// ShardRange 把 [0, n) 均分为 ShardTotal 段，返回本分片负责的 [lo, hi)；各段长度最多相差 1。
+// 适合按下标 / 自增 ID 区间切分的数据源；非分片执行时返回 (0, n)。
+func (j *Job) ShardRange(n int) (lo, hi int) {
+	if j.ShardTotal <= 1 || n <= 0 {
+		return 0, max(n, 0)
+	}
+	total, idx := int64(j.ShardTotal), int64(j.ShardIndex)
+	return int(int64(n) * idx / total), int(int64(n) * (idx + 1) / total)
+}
+
+// ShardResult ShardedHandler 单个分片的执行结果，JSON 编码后作为 run output 上报。
+type ShardResult struct {
+	ShardIndex int32 `json:"shard_index"`
+	ShardTotal int32 `json:"shard_total"`
+	// Total 本分片负责的条目数
+	Total int `json:"total"`
+	// Processed 成功处理的条目数
+	Processed int `json:"processed"`
+	// Failed 处理失败的条目数
+	Failed int `json:"failed"`
+	// Errors 前 10 条失败原因
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ShardedHandler 构造 EXECUTE_MODE_SHARDING job 的 handler：
+//  1. items 返回全量条目（各分片应得到相同的列表）
+//  2. key 非 nil 时按 Job.OwnsKey(key(item)) 挑出本分片的条目，nil 时按 Job.ShardRange 连续切分
+//  3. 逐条调用 process，并按完成比例上报进度（百分比变化时才上报）
+//
+// 单条失败不中断，计入 ShardResult.Failed；有失败时 run 以 FAILED 结束并仍上报 ShardResult 作为 output。
+// ctx 取消 / 超时时停止，已处理部分同样写入 output。父 run 上的跨分片汇总见 AdminClient.ShardAggregate。
+//
+//	c.RegisterHandler("device.reconcile", scheduler.ShardedHandler(
+//	    func(ctx context.Context, _ *scheduler.Job) ([]string, error) { return listDeviceNos(ctx) },
+//	    func(deviceNo string) string { return deviceNo },
+//	    func(ctx context.Context, _ *scheduler.Job, deviceNo string) error { return reconcile(ctx, deviceNo) },
+//	))
+func ShardedHandler[T any](
+	items func(ctx context.Context, job *Job) ([]T, error),
+	key func(T) string,
+	process func(ctx context.Context, job *Job, item T) error,
+) HandlerFunc {
+	if items == nil || process == nil {
+		panic("scheduler: ShardedHandler with nil items or process")
+	}
+	return func(ctx context.Context, job *Job) (string, error) {
+		all, err := items(ctx, job)
+		if err != nil {
+			return "", fmt.Errorf("scheduler: shard items: %w", err)
+		}
+		var mine []T
+		if key == nil {
+			lo, hi := job.ShardRange(len(all))
+			mine = all[lo:hi]
+		} else {
+			for _, it := range all {
+				if job.OwnsKey(key(it)) {
+					mine = append(mine, it)
+				}
+			}
+		}
+
+		res := ShardResult{ShardIndex: job.ShardIndex, ShardTotal: job.ShardTotal, Total: len(mine)}
+		lastPct := -1
+		for i, it := range mine {
+			if err := ctx.Err(); err != nil {
+				return "", WithOutput(err, res.encode())
+			}
+			if err := process(ctx, job, it); err != nil {
+				res.Failed++
+				if len(res.Errors) < shardMaxErrors {
+					res.Errors = append(res.Errors, err.Error())
+				}
+			} else {
+				res.Processed++
+			}
+			if pct := (i + 1) * 100 / len(mine); pct != lastPct {
+				lastPct = pct
+				_ = ReportProgress(ctx, pct, fmt.Sprintf("shard %d/%d: %d/%d", job.ShardIndex, job.ShardTotal, i+1, len(mine)))
+			}
+		}
+		out := res.encode()
+		if res.Failed > 0 {
+			return "", WithOutput(fmt.Errorf("scheduler: shard %d/%d: %d of %d items failed, first: %s",
+				job.ShardIndex, job.ShardTotal, res.Failed, res.Total, res.Errors[0]), out)
+		}
+		return out, nil
+	}
+}
+
+func (r ShardResult) encode() string {
+	b, _ := json.Marshal(r)
+	return string(b)
+}
+
+// ShardAggregate 一次分片触发的跨分片汇总。
+type ShardAggregate struct {
+	// Parent 父 run（TriggerJob / 调度返回的 run_id）
+	Parent *pb.Run
+	// Shards 各分片 run，按 shard_index 排序
+	Shards []*pb.Run
+	// Results 已上报 ShardResult 的分片结果（ShardedHandler 的 output），按 shard_index 排序
+	Results []ShardResult
+
+	// Total / Processed / Failed Results 的合计
+	Total     int
+	Processed int
+	Failed    int
+	// Finished 已结束的分片数
+	Finished int
+}
+
+// Done 全部分片已结束。
+func (g *ShardAggregate) Done() bool {
+	return len(g.Shards) > 0 && g.Finished == len(g.Shards)
+}
+
+// ShardAggregate 汇总父 run parentRunID 下全部分片 run 的状态与 ShardResult；
+// 分片 output 不是 ShardResult（未使用 ShardedHandler）时只计入 Shards / Finished。父 run 不存在返回 ErrNotFound。
+func (a *AdminClient) ShardAggregate(ctx context.Context, parentRunID string) (*ShardAggregate, error) {
+	parent, err := a.GetRun(ctx, parentRunID)
+	if err != nil {
+		return nil, err
+	}
+	g := &ShardAggregate{Parent: parent}
+	for run, err := range a.Runs(ctx, RunFilter{ParentRunID: parentRunID}) {
+		if err != nil {
+			return nil, err
+		}
+		if run.ShardTotal == 0 {
+			continue
+		}
+		g.Shards = append(g.Shards, run)
+	}
+	sort.Slice(g.Shards, func(i, j int) bool { return g.Shards[i].ShardIndex < g.Shards[j].ShardIndex })
+	for _, run := range g.Shards {
+		if runFinished(run) {
+			g.Finished++
+		}
+		var res ShardResult
+		if len(run.Output) == 0 || json.Unmarshal(run.Output, &res) != nil {
+			continue
+		}
+		g.Results = append(g.Results, res)
+		g.Total += res.Total
+		g.Processed += res.Processed
+		g.Failed += res.Failed
+	}
+	return g, nil
+}
@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	"github.com/sidchai/compkg/pkg/sharding"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+func TestJob_OwnsKeyMatchesSharding(t *testing.T) {
+	const total = 4 // 整除 pkg/sharding 默认的 64 张子表
+	for i := range 200 {
+		key := fmt.Sprintf("dev-%d", i)
+		owners := 0
+		for idx := range int32(total) {
+			if (&Job{ShardIndex: idx, ShardTotal: total}).OwnsKey(key) {
+				owners++
+				table := sharding.ApiRequestRecordTable(key)
+				n, _ := strconv.Atoi(strings.TrimPrefix(table, "api_request_record_"))
+				if n%total != int(idx) {
+					t.Fatalf("key %s: shard %d but table %s", key, idx, table)
+				}
+			}
+		}
+		if owners != 1 {
+			t.Fatalf("key %s owned by %d shards", key, owners)
+		}
+	}
+	if !(&Job{}).OwnsKey("anything") {
+		t.Fatal("non-sharded job must own every key")
+	}
+}
+
+func TestJob_ShardRangeCoversAll(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 100} {
+		next := 0
+		for idx := range int32(3) {
+			lo, hi := (&Job{ShardIndex: idx, ShardTotal: 3}).ShardRange(n)
+			if lo != next || hi < lo || hi-lo > n/3+1 {
+				t.Fatalf("n=%d shard %d: [%d,%d) after %d", n, idx, lo, hi, next)
+			}
+			next = hi
+		}
+		if next != n {
+			t.Fatalf("n=%d covered up to %d", n, next)
+		}
+	}
+	if lo, hi := (&Job{}).ShardRange(5); lo != 0 || hi != 5 {
+		t.Fatalf("non-sharded range [%d,%d)", lo, hi)
+	}
+}
+
+func TestIntegration_ShardedHandlerAggregate(t *testing.T) {
+	keys := make([]string, 20)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("dev-%02d", i)
+	}
+	srv, c := startFakeClient(t, schedulertest.Options{AutoDispatch: true}, nil, map[string]HandlerFunc{
+		"device.reconcile": ShardedHandler(
+			func(context.Context, *Job) ([]string, error) { return keys, nil },
+			func(k string) string { return k },
+			func(_ context.Context, _ *Job, k string) error {
+				if k == "dev-07" {
+					return errors.New("device offline")
+				}
+				return nil
+			},
+		),
+	})
+	ctx := waitCtx(t)
+	if err := srv.WaitWorker(ctx); err != nil {
+		t.Fatalf("WaitWorker: %v", err)
+	}
+	admin, err := c.Admin()
+	if err != nil {
+		t.Fatalf("Admin: %v", err)
+	}
+	if _, err := admin.CreateJob(ctx, &pb.Job{
+		JobName:     "device.reconcile",
+		ExecuteMode: pb.ExecuteMode_EXECUTE_MODE_SHARDING,
+		ShardTotal:  3,
+	}); err != nil {
+		t.Fatalf("CreateJob: %v", err)
+	}
+	parentID, err := admin.TriggerJob(ctx, "device.reconcile", TriggerOptions{})
+	if err != nil {
+		t.Fatalf("TriggerJob: %v", err)
+	}
+	parent, err := c.WaitRun(ctx, parentID)
+	if err != nil {
+		t.Fatalf("WaitRun: %v", err)
+	}
+	if parent.Status != pb.RunStatus_RUN_STATUS_FAILED {
+		t.Fatalf("parent status=%s, want FAILED with one failed shard", parent.Status)
+	}
+
+	agg, err := admin.ShardAggregate(ctx, parentID)
+	if err != nil {
+		t.Fatalf("ShardAggregate: %v", err)
+	}
+	if !agg.Done() || len(agg.Shards) != 3 || len(agg.Results) != 3 {
+		t.Fatalf("aggregate shards=%d results=%d finished=%d", len(agg.Shards), len(agg.Results), agg.Finished)
+	}
+	if agg.Total != len(keys) || agg.Processed != len(keys)-1 || agg.Failed != 1 {
+		t.Fatalf("aggregate total=%d processed=%d failed=%d", agg.Total, agg.Processed, agg.Failed)
+	}
+	for i, r := range agg.Results {
+		if r.ShardIndex != int32(i) || r.ShardTotal != 3 {
+			t.Fatalf("result %d: %+v", i, r)
+		}
+		if r.Failed > 0 && (len(r.Errors) != 1 || r.Errors[0] != "device offline") {
+			t.Fatalf("failed shard errors: %+v", r)
+		}
+	}
+	for _, run := range agg.Shards {
+		if run.ProgressPercent != 100 {
+			t.Fatalf("shard %d progress=%d", run.ShardIndex, run.ProgressPercent)
+		}
+	}
+}
@@ -1,12 +1,19 @@
 package scheduler
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/credentials"
 )
 
 // sign 生成与服务端 sigverify.Verify 兼容的 HMAC-SHA256 签名。
@@ -38,13 +45,134 @@ type signedCreds struct {
 	Signature string
 }
 
-// newSignedCreds 用当前时间 + 新 nonce 生成一组签名凭据。
-func newSignedCreds(appKey, appSecret string) signedCreds {
+// newSignedCreds 用 now（经 clockSkew 校正的当前时间）+ 新 nonce 生成一组签名凭据。
+func newSignedCreds(appKey, appSecret string, now time.Time) signedCreds {
 	nonce := newNonce()
-	ts := time.Now().Unix()
+	ts := now.Unix()
 	return signedCreds{
 		Nonce:     nonce,
 		Ts:        ts,
 		Signature: sign(appSecret, appKey, nonce, ts),
 	}
 }
+
+// unary RPC 签名凭据所用的 metadata key；字段含义与 SubmitTaskRequest 的同名字段一致。
+const (
+	mdAppName   = "x-app-name"
+	mdAppKey    = "x-app-key"
+	mdNonce     = "x-nonce"
+	mdTs        = "x-ts"
+	mdSignature = "x-signature"
+)
+
+// NewHMACCredentials 返回按 SDK 签名算法为每次调用附带新签名（x-app-name / x-app-key / x-nonce / x-ts / x-signature）的
+// gRPC PerRPCCredentials。Client / AdminClient 已内置；自行拨号调用 SchedulerService 等服务时可直接使用：
+//
+//	conn, err := grpc.NewClient(endpoint,
+//	    grpc.WithTransportCredentials(creds),
+//	    grpc.WithPerRPCCredentials(scheduler.NewHMACCredentials(appName, appKey, appSecret)),
+//	)
+func NewHMACCredentials(appName, appKey, appSecret string) credentials.PerRPCCredentials {
+	return hmacCredentials{appName: appName, appKey: appKey, appSecret: appSecret}
+}
+
+// hmacCredentials 以 gRPC PerRPCCredentials 的形式为每次调用附带一组新签名。
+//
+// 管理类 RPC 与 GetRun / CancelRun / WatchRun 的请求体不含签名字段，服务端从 metadata 读取并按 sign() 同一算法校验；
+// Client 与 AdminClient 共用这份凭据。
+type hmacCredentials struct {
+	appName   string
+	appKey    string
+	appSecret string
+	clock     *clockSkew // 签名 ts 的时钟校正；NewHMACCredentials 返回的实例为 nil，即本地时间
+}
+
+var _ credentials.PerRPCCredentials = hmacCredentials{}
+
+// GetRequestMetadata 每次调用生成新的 nonce + ts（按 clock 校正），避免重放窗口内复用签名。
+func (h hmacCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	creds := newSignedCreds(h.appKey, h.appSecret, h.clock.now())
+	return map[string]string{
+		mdAppName:   h.appName,
+		mdAppKey:    h.appKey,
+		mdNonce:     creds.Nonce,
+		mdTs:        strconv.FormatInt(creds.Ts, 10),
+		mdSignature: creds.Signature,
+	}, nil
+}
+
+// RequireTransportSecurity 签名本身防篡改，明文与 TLS 连接均可使用。
+func (hmacCredentials) RequireTransportSecurity() bool { return false }
+
+// mdAuthorization 管理面 RPC 携带 JWT 的 metadata key，值为 "Bearer <token>"（REST 网关下即 Authorization 头）。
+const mdAuthorization = "authorization"
+
+// jwtServices 整个服务均为 UI / 管理面、按 JWT 鉴权的服务。
+var jwtServices = []string{pb.AppService_ServiceDesc.ServiceName, pb.AlertService_ServiceDesc.ServiceName}
+
+// jwtSchedulerMethods SchedulerService 中可附带 JWT 的方法："Web UI 使用" 的管理方法，以及请求体不含签名字段、
+// 与管理方法一样经 metadata 鉴权的 GetRun / CancelRun / WatchRun。SubmitTask / SubmitTasks 在请求体内签名，不附带 token。
+var jwtSchedulerMethods = map[string]bool{
+	pb.SchedulerService_GetRun_FullMethodName:               true,
+	pb.SchedulerService_CancelRun_FullMethodName:            true,
+	pb.SchedulerService_WatchRun_FullMethodName:             true,
+	pb.SchedulerService_ListJobs_FullMethodName:             true,
+	pb.SchedulerService_GetJob_FullMethodName:               true,
+	pb.SchedulerService_CreateJob_FullMethodName:            true,
+	pb.SchedulerService_UpdateJob_FullMethodName:            true,
+	pb.SchedulerService_DeleteJob_FullMethodName:            true,
+	pb.SchedulerService_PauseJob_FullMethodName:             true,
+	pb.SchedulerService_ResumeJob_FullMethodName:            true,
+	pb.SchedulerService_TriggerJob_FullMethodName:           true,
+	pb.SchedulerService_ListRuns_FullMethodName:             true,
+	pb.SchedulerService_RetryRun_FullMethodName:             true,
+	pb.SchedulerService_ListWorkers_FullMethodName:          true,
+	pb.SchedulerService_KickWorker_FullMethodName:           true,
+	pb.SchedulerService_GetDashboard_FullMethodName:         true,
+	pb.SchedulerService_ListPendingChanges_FullMethodName:   true,
+	pb.SchedulerService_ApprovePendingChange_FullMethodName: true,
+	pb.SchedulerService_RejectPendingChange_FullMethodName:  true,
+}
+
+// jwtMethod method 形如 "/scheduler.v1.SchedulerService/ListJobs"；可附带 JWT 时返回 true。
+func jwtMethod(method string) bool {
+	if jwtSchedulerMethods[method] {
+		return true
+	}
+	svc, _, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	return ok && slices.Contains(jwtServices, svc)
+}
+
+// jwtCredentials 以 gRPC PerRPCCredentials 的形式为管理面 RPC 附带 JWT（Config.AdminToken / AdminTokenSource）。
+//
+// 可选：hmacCredentials 的签名始终附带，JWT 用于以 UI 用户（UserService 登录获得）身份操作、不受应用归属限制的场景。
+// SubmitTask / SubmitTasks 与 WorkerService 不附带 token；未配置 token 时不附带任何 metadata。
+type jwtCredentials struct {
+	token  string
+	source func(ctx context.Context) (string, error)
+}
+
+var _ credentials.PerRPCCredentials = jwtCredentials{}
+
+// GetRequestMetadata 仅对 jwtMethod 附带 token；source 每次调用取值，便于 token 过期前续签。
+func (j jwtCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	ri, _ := credentials.RequestInfoFromContext(ctx)
+	if !jwtMethod(ri.Method) {
+		return nil, nil
+	}
+	token := j.token
+	if j.source != nil {
+		t, err := j.source(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler: admin token: %w", err)
+		}
+		token = t
+	}
+	if token == "" {
+		return nil, nil
+	}
+	return map[string]string{mdAuthorization: "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity 与连接配置一致，明文与 TLS 连接均可使用；生产环境建议开启 TLS，避免 token 明文传输。
+func (jwtCredentials) RequireTransportSecurity() bool { return false }
@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
@@ -53,7 +54,7 @@ func TestNewSignedCreds_Verifiable(t *testing.T) {
 		appKey    = "ak_test"
 		appSecret = "ss_test"
 	)
-	creds := newSignedCreds(appKey, appSecret)
+	creds := newSignedCreds(appKey, appSecret, time.Now())
 
 	if creds.Nonce == "" || creds.Signature == "" {
 		t.Fatalf("creds incomplete: %+v", creds)
@@ -70,3 +71,38 @@ func TestNewSignedCreds_Verifiable(t *testing.T) {
 		t.Fatalf("creds.Signature mismatch:\n got=%s\nwant=%s", creds.Signature, expected)
 	}
 }
+
+func TestHMACCredentials_FreshClockCorrectedSignature(t *testing.T) {
+	clock := &clockSkew{}
+	clock.offset.Store(int64((10 * time.Minute).Seconds()))
+	creds := hmacCredentials{appName: "app", appKey: "ak_test", appSecret: "ss_test", clock: clock}
+
+	md1, err := creds.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata: %v", err)
+	}
+	md2, _ := creds.GetRequestMetadata(context.Background())
+	if md1[mdNonce] == "" || md1[mdNonce] == md2[mdNonce] {
+		t.Fatalf("expect fresh nonce per call: %q %q", md1[mdNonce], md2[mdNonce])
+	}
+	if md1[mdAppName] != "app" || md1[mdAppKey] != "ak_test" {
+		t.Fatalf("md=%v", md1)
+	}
+	ts, err := strconv.ParseInt(md1[mdTs], 10, 64)
+	if err != nil {
+		t.Fatalf("x-ts: %v", err)
+	}
+	// ts 按 clock 偏移校正
+	if diff := ts - time.Now().Add(10*time.Minute).Unix(); diff < -5 || diff > 5 {
+		t.Fatalf("ts=%d not clock-corrected", ts)
+	}
+	if want := sign("ss_test", "ak_test", md1[mdNonce], ts); md1[mdSignature] != want {
+		t.Fatalf("signature mismatch:\n got=%s\nwant=%s", md1[mdSignature], want)
+	}
+
+	// 导出构造不带 clock，按本地时间签名
+	md, _ := NewHMACCredentials("app", "ak_test", "ss_test").GetRequestMetadata(context.Background())
+	if ts, _ := strconv.ParseInt(md[mdTs], 10, 64); time.Now().Unix()-ts > 5 {
+		t.Fatalf("NewHMACCredentials ts=%d", ts)
+	}
+}
@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/sidchai/compkg/pkg/compression"
+	// 注册 gzip，供 Config.LocalBufferDiskSpillCompression 按名查找
+	_ "github.com/sidchai/compkg/pkg/compression/impl"
+	"github.com/sidchai/compkg/pkg/encrypt"
+)
+
+// 磁盘 spool 记录格式（.spool）：
+//
+//	magic "SSP1" | flags(1) | len(codec)(1) | codec | crc32(4, IEEE, body) | len(body)(4) | body
+//
+// body = AES-GCM(compress(JSON(spoolTask)))，压缩、加密按 flags 可选；codec 为压缩算法的 pkg/compression 注册名，
+// 读取时按记录自身的 flags / codec 解码，调整配置不影响已落盘的记录。进程崩溃留下的半截记录长度或 CRC 对不上，
+// 由 submitSpool 隔离后跳过。
+const (
+	spoolMagic = "SSP1"
+
+	spoolFlagCompressed byte = 1 << 0
+	spoolFlagEncrypted  byte = 1 << 1
+)
+
+// errSpoolCorrupt spool 记录无法解码（半截写入、CRC 不符、缺少或密钥错误）。
+var errSpoolCorrupt = errors.New("scheduler: corrupted spool record")
+
+// spoolCodec 磁盘 spool 记录的封装 / 解封装；nil 表示不压缩不加密（仍带头部与 CRC）。
+type spoolCodec struct {
+	compressName string
+	comp         compression.Compression
+	gcm          *encrypt.AesGcm
+}
+
+// newSpoolCodec 按 Config 构造；参数已由 Config.Validate 校验。
+func newSpoolCodec(cfg *Config) *spoolCodec {
+	sc := &spoolCodec{}
+	if cfg.LocalBufferDiskSpillCompression != "" {
+		sc.compressName = cfg.LocalBufferDiskSpillCompression
+		sc.comp = compression.GetCompression(sc.compressName)
+	}
+	if len(cfg.LocalBufferDiskSpillKey) > 0 {
+		sc.gcm = &encrypt.AesGcm{Key: cfg.LocalBufferDiskSpillKey}
+	}
+	return sc
+}
+
+// seal 把明文 JSON 封装为一条 spool 记录。
+func (sc *spoolCodec) seal(plain []byte) ([]byte, error) {
+	var flags byte
+	var name string
+	body := plain
+	if sc != nil && sc.comp != nil {
+		compressed, err := sc.comp.Compress(body)
+		if err != nil {
+			return nil, fmt.Errorf("compress scheduler spool task: %w", err)
+		}
+		body, name, flags = compressed, sc.compressName, flags|spoolFlagCompressed
+	}
+	if sc != nil && sc.gcm != nil {
+		// AesGcm.Encrypt 输出 Base64(nonce|ciphertext)，落盘存原始字节
+		sealed, err := sc.gcm.Encrypt(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("encrypt scheduler spool task: %w", err)
+		}
+		if body, err = base64.StdEncoding.DecodeString(sealed); err != nil {
+			return nil, fmt.Errorf("encrypt scheduler spool task: %w", err)
+		}
+		flags |= spoolFlagEncrypted
+	}
+
+	rec := make([]byte, 0, len(spoolMagic)+2+len(name)+8+len(body))
+	rec = append(rec, spoolMagic...)
+	rec = append(rec, flags, byte(len(name)))
+	rec = append(rec, name...)
+	rec = binary.BigEndian.AppendUint32(rec, crc32.ChecksumIEEE(body))
+	rec = binary.BigEndian.AppendUint32(rec, uint32(len(body)))
+	return append(rec, body...), nil
+}
+
+// open 校验并解封装一条 spool 记录，返回明文 JSON；无法解码时返回包装 errSpoolCorrupt 的错误。
+func (sc *spoolCodec) open(rec []byte) ([]byte, error) {
+	if len(rec) < len(spoolMagic)+2 || string(rec[:len(spoolMagic)]) != spoolMagic {
+		return nil, fmt.Errorf("%w: bad header", errSpoolCorrupt)
+	}
+	flags, nameLen := rec[len(spoolMagic)], int(rec[len(spoolMagic)+1])
+	rest := rec[len(spoolMagic)+2:]
+	if len(rest) < nameLen+8 {
+		return nil, fmt.Errorf("%w: truncated header", errSpoolCorrupt)
+	}
+	name := string(rest[:nameLen])
+	sum := binary.BigEndian.Uint32(rest[nameLen:])
+	size := binary.BigEndian.Uint32(rest[nameLen+4:])
+	body := rest[nameLen+8:]
+	if uint32(len(body)) != size {
+		return nil, fmt.Errorf("%w: body %d bytes, want %d", errSpoolCorrupt, len(body), size)
+	}
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, fmt.Errorf("%w: crc mismatch", errSpoolCorrupt)
+	}
+
+	if flags&spoolFlagEncrypted != 0 {
+		if sc == nil || sc.gcm == nil {
+			return nil, fmt.Errorf("%w: encrypted record but LocalBufferDiskSpillKey not set", errSpoolCorrupt)
+		}
+		plain, err := sc.gcm.Decrypt(body)
+		if err != nil {
+			return nil, fmt.Errorf("%w: decrypt: %v", errSpoolCorrupt, err)
+		}
+		body = []byte(plain)
+	}
+	if flags&spoolFlagCompressed != 0 {
+		comp := compression.GetCompression(name)
+		if comp == nil {
+			return nil, fmt.Errorf("%w: unknown compression %q", errSpoolCorrupt, name)
+		}
+		plain, err := comp.Decompress(body)
+		if err != nil {
+			return nil, fmt.Errorf("%w: decompress: %v", errSpoolCorrupt, err)
+		}
+		body = plain
+	}
+	return body, nil
+}
@@ -0,0 +1,207 @@
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSpoolCodec() *spoolCodec {
+	return newSpoolCodec(&Config{
+		LocalBufferDiskSpillCompression: "gzip",
+		LocalBufferDiskSpillKey:         []byte("0123456789abcdef"),
+	})
+}
+
+func pushSpool(t *testing.T, s *submitSpool, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		if err := s.push(bufferedTask{
+			opts:       SubmitOptions{JobName: name, BizKey: "device-" + name, Payload: []byte(`{"imei":"860000000000001"}`)},
+			enqueuedAt: time.Now(),
+		}); err != nil {
+			t.Fatalf("push %s: %v", name, err)
+		}
+	}
+}
+
+func spoolFiles(t *testing.T, dir, ext string) []string {
+	t.Helper()
+	matches, err := filepath.Glob(filepath.Join(dir, "*"+ext))
+	if err != nil {
+		t.Fatalf("glob: %v", err)
+	}
+	return matches
+}
+
+func drainNames(t *testing.T, s *submitSpool) []string {
+	t.Helper()
+	items, err := s.drain(10)
+	if err != nil {
+		t.Fatalf("drain: %v", err)
+	}
+	var names []string
+	for _, it := range items {
+		names = append(names, it.Opts.JobName)
+	}
+	return names
+}
+
+func TestSubmitSpool_CompressedEncrypted(t *testing.T) {
+	dir := t.TempDir()
+	spool, err := newSubmitSpool(dir, 1024*1024, testSpoolCodec())
+	if err != nil {
+		t.Fatalf("newSubmitSpool: %v", err)
+	}
+	pushSpool(t, spool, "job-a")
+
+	files := spoolFiles(t, dir, spoolExt)
+	if len(files) != 1 {
+		t.Fatalf("spool files=%v", files)
+	}
+	raw, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(raw, []byte("860000000000001")) || bytes.Contains(raw, []byte("device-job-a")) {
+		t.Fatal("spool record stored in plain text")
+	}
+
+	reloaded, err := newSubmitSpool(dir, 1024*1024, testSpoolCodec())
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if got := drainNames(t, reloaded); len(got) != 1 || got[0] != "job-a" {
+		t.Fatalf("drain=%v", got)
+	}
+
+	// 未配置密钥时无法解密：隔离为 .corrupt，不阻塞加载
+	plain, err := newSubmitSpool(dir, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("reload without key: %v", err)
+	}
+	if plain.len() != 0 || len(spoolFiles(t, dir, spoolCorruptExt)) != 1 || plain.corrupted.Load() != 1 {
+		t.Fatalf("len=%d corrupt=%v", plain.len(), spoolFiles(t, dir, spoolCorruptExt))
+	}
+}
+
+func TestSubmitSpool_TornRecordSkipped(t *testing.T) {
+	dir := t.TempDir()
+	spool, err := newSubmitSpool(dir, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("newSubmitSpool: %v", err)
+	}
+	pushSpool(t, spool, "job-a", "job-b", "job-c")
+	files := spoolFiles(t, dir, spoolExt)
+
+	// 已加载的记录在 drain 时发现损坏：跳过并隔离，其余照常返回
+	if err := os.Truncate(files[1], 20); err != nil {
+		t.Fatal(err)
+	}
+	if got := drainNames(t, spool); strings.Join(got, ",") != "job-a,job-c" {
+		t.Fatalf("drain=%v", got)
+	}
+	if spool.len() != 2 {
+		t.Fatalf("len=%d want 2", spool.len())
+	}
+
+	// 重启加载：半截记录（CRC 不符）与残留 .tmp 不影响其余记录
+	raw, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw[len(raw)-1] ^= 0xff
+	if err := os.WriteFile(files[0], raw, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "00000000000000000009.spool.tmp"), []byte("SSP"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := newSubmitSpool(dir, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if got := drainNames(t, reloaded); len(got) != 1 || got[0] != "job-c" {
+		t.Fatalf("drain after reload=%v", got)
+	}
+	if n := len(spoolFiles(t, dir, spoolCorruptExt)); n != 2 {
+		t.Fatalf("corrupt files=%d want 2", n)
+	}
+	if n := len(spoolFiles(t, dir, ".tmp")); n != 0 {
+		t.Fatalf("leftover tmp files=%d", n)
+	}
+}
+
+func TestSubmitSpool_MigratesLegacyJSON(t *testing.T) {
+	dir := t.TempDir()
+	for i, name := range []string{"job-a", "job-b"} {
+		seq := int64(i + 1)
+		data, err := json.Marshal(spoolTask{Seq: seq, Opts: SubmitOptions{JobName: name}, EnqueuedAt: time.Now().UnixNano()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("%020d%s", seq, spoolLegacyExt)), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 迁移中途崩溃：seq=1 已写出 .spool 但 .json 未删除
+	if _, err := (&submitSpool{dir: dir}).writeRecord(1, mustSeal(t, nil, `{"seq":1,"opts":{"JobName":"job-a"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("%020d%s", 3, spoolLegacyExt)), []byte(`{"seq":3,`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	spool, err := newSubmitSpool(dir, 1024*1024, testSpoolCodec())
+	if err != nil {
+		t.Fatalf("newSubmitSpool: %v", err)
+	}
+	if got := drainNames(t, spool); strings.Join(got, ",") != "job-a,job-b" {
+		t.Fatalf("drain=%v", got)
+	}
+	if n := len(spoolFiles(t, dir, spoolLegacyExt)); n != 0 {
+		t.Fatalf("legacy files left=%d", n)
+	}
+	if n := len(spoolFiles(t, dir, spoolCorruptExt)); n != 1 {
+		t.Fatalf("corrupt legacy files=%d want 1", n)
+	}
+	for _, f := range spoolFiles(t, dir, spoolExt) {
+		raw, _ := os.ReadFile(f)
+		if bytes.Contains(raw, []byte("job-")) {
+			t.Fatalf("%s not re-encoded with current codec", f)
+		}
+	}
+}
+
+func TestConfig_SpoolCodecValidation(t *testing.T) {
+	base := Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", PayloadCodec: CodecJSON}
+	cfg := base
+	cfg.LocalBufferDiskSpillCompression = "zstd-missing"
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("unknown compression must be rejected")
+	}
+	cfg = base
+	cfg.LocalBufferDiskSpillKey = []byte("short")
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("non 16-byte key must be rejected")
+	}
+	cfg = base
+	cfg.LocalBufferDiskSpillCompression, cfg.LocalBufferDiskSpillKey = "gzip", []byte("0123456789abcdef")
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
+
+func mustSeal(t *testing.T, sc *spoolCodec, plain string) []byte {
+	t.Helper()
+	rec, err := sc.seal([]byte(plain))
+	if err != nil {
+		t.Fatalf("seal: %v", err)
+	}
+	return rec
+}
@@ -33,8 +33,14 @@ func (c *Client) runStreamLoop() {
 		if time.Since(sessionStart) > 30*time.Second {
 			backoff = c.cfg.ReconnectMinBackoff
 		}
+		// 只在已连接 → 断开时重置起点：连续重连失败期间 scheduler_sdk_disconnected_seconds 持续增长
+		if c.sessionUp.Load() {
+			c.setSessionState(false)
+		}
+		c.reconnects.Add(1)
 		if err != nil {
 			logger.Warnf("[scheduler-sdk] session ended err=%v, reconnect in %s", err, backoff)
+			c.recordError(errSourceSession, "", "", err.Error())
 		} else {
 			logger.Warnf("[scheduler-sdk] session ended without error, reconnect in %s", backoff)
 		}
@@ -63,7 +69,7 @@ func (c *Client) runOneSession(parent context.Context) error {
 	}
 
 	// 1. 发送 RegisterRequest
-	creds := newSignedCreds(c.cfg.AppKey, c.cfg.AppSecret)
+	creds := newSignedCreds(c.cfg.AppKey, c.cfg.AppSecret, c.clock.now())
 	if err := stream.Send(&pb.WorkerMessage{
 		Payload: &pb.WorkerMessage_Register{
 			Register: &pb.RegisterRequest{
@@ -78,6 +84,7 @@ func (c *Client) runOneSession(parent context.Context) error {
 				SdkVersion:     c.cfg.SDKVersion,
 				HandlerJobs:    c.handlerNames(),
 				MaxConcurrency: int32(c.cfg.MaxConcurrency),
+				Draining:       c.draining.Load(),
 			},
 		},
 	}); err != nil {
@@ -93,32 +100,99 @@ func (c *Client) runOneSession(parent context.Context) error {
 	if resp == nil {
 		return errors.New("first server message is not RegisterResponse")
 	}
+	// 鉴权失败的响应同样携带 server_ts：时钟超窗被拒后，下一次重连即按校正后的时间签名
+	c.clock.observe(resp.ServerTs)
 	if !resp.Ok {
 		// 鉴权失败：固定错误，外层退避重连仍可能有效（如配置已刷新），但记 error 级
 		logger.Errorf("[scheduler-sdk] register denied: %s", resp.Error)
 		return errors.New("register denied: " + resp.Error)
 	}
+	c.heartbeatMu.Lock()
 	if resp.HeartbeatInterval > 0 {
-		c.heartbeatMu.Lock()
 		c.negotiatedHbDelay = time.Duration(resp.HeartbeatInterval) * time.Second
-		c.heartbeatMu.Unlock()
 	}
+	c.schedulerLeader = resp.SchedulerLeader
+	c.heartbeatMu.Unlock()
+	c.routing.setLeader(resp.SchedulerLeader)
+	c.setSessionState(true)
 	logger.Infof("[scheduler-sdk] connected app=%s worker=%s sched_leader=%s hb=%ds",
 		c.cfg.AppName, c.cfg.WorkerID, resp.SchedulerLeader, resp.HeartbeatInterval)
 
-	// 3. sendCh + sender goroutine：序列化所有 stream.Send，避免并发写
+	// 3. sendCh + sender goroutine：序列化所有 stream.Send，避免并发写；
+	//    同时负责 flush 结果发件箱：先重放上个 session 未送达的 JobResult，之后每次 put 时唤醒；
+	//    已发送结果在 session 存活超过 2 个心跳间隔后才确认移除。
+	//    进度先于结果 flush：同一 run 的最后一次进度（含 checkpoint）总在其结果之前到达服务端
 	sendCh := make(chan *pb.WorkerMessage, 64)
 	sendErrCh := make(chan error, 1)
 	var senderWG sync.WaitGroup
 	senderWG.Add(1)
 	go func() {
 		defer senderWG.Done()
-		for msg := range sendCh {
-			if err := stream.Send(msg); err != nil {
+		fail := func(err error) {
+			select {
+			case sendErrCh <- err:
+			default:
+			}
+			// 让 recv loop 尽快退出，避免 session 半死不活
+			cancel()
+		}
+		// flush 先写出 sendCh 中已排队的消息再 flush 进度（与结果）：run 的 Ack 在 handler 启动前入队，
+		// 保证同一 run 按 Ack → Progress → Result 的顺序到达。sendCh 已关闭时返回 open=false
+		flush := func(results bool) (open bool, err error) {
+			for queued := true; queued; {
 				select {
-				case sendErrCh <- err:
+				case msg, ok := <-sendCh:
+					if !ok {
+						return false, nil
+					}
+					if err := stream.Send(msg); err != nil {
+						return true, err
+					}
 				default:
+					queued = false
 				}
+			}
+			if err := c.flushProgress(stream.Send); err != nil {
+				return true, err
+			}
+			if results {
+				return true, c.flushResultOutbox(stream.Send)
+			}
+			return true, nil
+		}
+		// 先重放上个 session 遗留的进度与结果；此时 recv loop 可能已收到新 Dispatch 并把 Ack 放入 sendCh
+		if open, err := flush(true); err != nil || !open {
+			if err != nil {
+				fail(err)
+			}
+			return
+		}
+		confirmWindow := 2 * c.heartbeatDelay()
+		confirmTicker := time.NewTicker(confirmWindow)
+		defer confirmTicker.Stop()
+		for {
+			open, err := true, error(nil)
+			select {
+			case msg, ok := <-sendCh:
+				if !ok {
+					return
+				}
+				err = stream.Send(msg)
+			case <-c.progressBox.notify:
+				open, err = flush(false)
+			case <-c.resultOutbox.notify:
+				open, err = flush(true)
+			case <-confirmTicker.C:
+				if payloads := c.resultOutbox.confirmSent(time.Now().Add(-confirmWindow)); len(payloads) > 0 {
+					// 对象存储调用可能较慢，不阻塞 sender
+					go c.offload.removeAll(payloads)
+				}
+			}
+			if err != nil {
+				fail(err)
+				return
+			}
+			if !open {
 				return
 			}
 		}
@@ -131,11 +205,13 @@ func (c *Client) runOneSession(parent context.Context) error {
 	// 5. recv loop：阻塞接收 SchedulerMessage 并派发
 	recvErr := c.runRecvLoop(sessCtx, stream, sendCh)
 
-	// 6. 清理：cancel session → close sendCh → 等 sender 收尾
+	// 6. 清理：cancel session → 等 heartbeat 退出 → close sendCh → 等 sender 收尾 → 未确认结果重新入队
+	//    heartbeat 必须先退出再 close，否则其 select 可能选中向已关闭 channel 发送而 panic
 	cancel()
-	close(sendCh)
 	<-hbDone
+	close(sendCh)
 	senderWG.Wait()
+	c.resultOutbox.requeueSent()
 
 	// 区分错误来源：sendErr 优先（更接近底层）
 	select {
@@ -175,45 +251,47 @@ func (c *Client) runRecvLoop(ctx context.Context, stream pb.WorkerService_Connec
 	}
 }
 
-// runHeartbeat 按 negotiatedHbDelay 定期向 sendCh 推 Heartbeat，直到 ctx 取消。
+// runHeartbeat 按 negotiatedHbDelay 定期向 sendCh 推 Heartbeat，直到 ctx 取消；heartbeatNow 触发时立即补发一次。
 func (c *Client) runHeartbeat(ctx context.Context, sendCh chan<- *pb.WorkerMessage, done chan struct{}) {
 	defer close(done)
 
-	c.heartbeatMu.Lock()
-	delay := c.negotiatedHbDelay
-	c.heartbeatMu.Unlock()
-	if delay <= 0 {
-		delay = c.cfg.HeartbeatInterval
-	}
-
 	start := time.Now()
-	ticker := time.NewTicker(delay)
+	ticker := time.NewTicker(c.heartbeatDelay())
 	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			inflight := int32(c.currentInflight())
-			hb := &pb.Heartbeat{
-				WorkerId:  c.cfg.WorkerID,
-				Ts:        time.Now().Unix(),
-				Inflight:  inflight,
-				LoadAvg:   0, // load_avg 待接入 metrics 后填充
-				UptimeSec: int64(time.Since(start).Seconds()),
-			}
-			select {
-			case sendCh <- &pb.WorkerMessage{Payload: &pb.WorkerMessage_Heartbeat{Heartbeat: hb}}:
-			case <-ctx.Done():
-				return
-			}
+		case <-c.heartbeatNow:
+		}
+		inflight, queued := c.runQueue.stats()
+		hb := &pb.Heartbeat{
+			WorkerId:  c.cfg.WorkerID,
+			Ts:        time.Now().Unix(),
+			Inflight:  int32(inflight),
+			LoadAvg:   0, // load_avg 待接入 metrics 后填充
+			UptimeSec: int64(time.Since(start).Seconds()),
+			Queued:    int32(queued),
+			Draining:  c.draining.Load(),
+		}
+		select {
+		case sendCh <- &pb.WorkerMessage{Payload: &pb.WorkerMessage_Heartbeat{Heartbeat: hb}}:
+		case <-ctx.Done():
+			return
 		}
 	}
 }
 
-// currentInflight 当前 inflight handler 数量 = MaxConcurrency - sem 剩余容量。
-func (c *Client) currentInflight() int {
-	return c.cfg.MaxConcurrency - (cap(c.concurrencySem) - len(c.concurrencySem))
+// heartbeatDelay 当前 session 的心跳间隔：优先服务端协商值，否则 Config.HeartbeatInterval。
+func (c *Client) heartbeatDelay() time.Duration {
+	c.heartbeatMu.Lock()
+	delay := c.negotiatedHbDelay
+	c.heartbeatMu.Unlock()
+	if delay <= 0 {
+		delay = c.cfg.HeartbeatInterval
+	}
+	return delay
 }
 
 // onCancel 处理 server 主动取消：通过 jobCancels 找到对应 handler ctx 并 cancel。
@@ -222,14 +300,27 @@ func (c *Client) onCancel(ctx context.Context, m *pb.Cancel) {
 		return
 	}
 	c.cancelsMu.Lock()
-	cancel, ok := c.cancels[m.RunId]
+	t, ok := c.cancels[m.RunId]
 	c.cancelsMu.Unlock()
 	if !ok {
 		logger.Warnf("[scheduler-sdk] cancel for unknown run_id=%s reason=%s", m.RunId, m.Reason)
 		return
 	}
 	logger.Infof("[scheduler-sdk] cancel run_id=%s reason=%s", m.RunId, m.Reason)
-	cancel()
+	c.cancelTask(t)
+}
+
+// cancelTask 取消 handler ctx；仍在等待队列中的 run 立即出队上报 CANCELED，不占用队列容量。
+func (c *Client) cancelTask(t *dispatchTask) {
+	t.cancel()
+	c.dequeueExpired(t)
+}
+
+// dequeueExpired 把 ctx 已结束（取消 / 排队超时）但仍在等待队列中的 t 出队，按 CANCELED / TIMEOUT 上报。
+func (c *Client) dequeueExpired(t *dispatchTask) {
+	if c.runQueue.remove(t) {
+		go c.execute(t, false)
+	}
 }
 
 // onReload 服务端 Reload 当前 SDK 是无状态（handler 注册由代码控制），仅记 info。
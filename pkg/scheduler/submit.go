@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	pb "github.com/sidchai/compkg/proto/scheduler/v1"
 )
@@ -25,9 +26,35 @@ type SubmitOptions struct {
 	// DedupeWindowSec 去重窗口（秒）；0 表示不去重
 	DedupeWindowSec int32
 
-	// TraceID / SpanID 透传链路；为空时服务端会自动生成
+	// TraceID / SpanID 透传链路；为空时 SDK 取 ctx 中当前 span（trace.SpanContextFromContext），
+	// ctx 也无 span 时由服务端自动生成
 	TraceID string
 	SpanID  string
+
+	// RunAt 定时执行：服务端持久化为延迟 run，到期后再派发，进程重启不丢；零值或已过去的时间表示立即入队。
+	// 按绝对时间传递，"设备本地 03:00" 之类用对应时区构造 time.Time 即可
+	RunAt time.Time
+
+	// Delay 延迟执行，提交时换算为 RunAt=now+Delay；与 RunAt 互斥，不能为负
+	// EnqueueTask 在入队前就完成换算，本地缓冲 / 磁盘 spill 重放时仍按最初计划的时间执行
+	Delay time.Duration
+
+	// Priority 覆盖 job 配置的优先级；PRIORITY_UNSPECIFIED 沿用 job 配置
+	Priority pb.Priority
+}
+
+// resolveSchedule 校验 RunAt / Delay，并把 Delay 换算为绝对的 RunAt。
+func (o *SubmitOptions) resolveSchedule(now time.Time) error {
+	switch {
+	case o.Delay < 0:
+		return fmt.Errorf("scheduler: SubmitOptions.Delay must not be negative, got %s", o.Delay)
+	case o.Delay > 0 && !o.RunAt.IsZero():
+		return errors.New("scheduler: SubmitOptions.RunAt and Delay are mutually exclusive")
+	case o.Delay > 0:
+		o.RunAt = now.Add(o.Delay)
+		o.Delay = 0
+	}
+	return nil
 }
 
 // ErrSubmitNotConnected SubmitTask 在 Start 之前调用。
@@ -41,6 +68,7 @@ var ErrSubmitNotConnected = errors.New("scheduler: SubmitTask requires Start() f
 //   - err：gRPC 错误或入参错误
 //
 // 超时：使用 Config.SubmitTimeout（默认 5s）；可通过 ctx 进一步压缩。
+// 设置 RunAt / Delay 时 runID 立即返回，run 保持 PENDING 直到计划时间。
 func (c *Client) SubmitTask(ctx context.Context, opts SubmitOptions) (runID string, dedup bool, err error) {
 	if c.schedCli == nil {
 		return "", false, ErrSubmitNotConnected
@@ -48,6 +76,13 @@ func (c *Client) SubmitTask(ctx context.Context, opts SubmitOptions) (runID stri
 	if opts.JobName == "" {
 		return "", false, errors.New("scheduler: SubmitOptions.JobName required")
 	}
+	if err := opts.resolveSchedule(time.Now()); err != nil {
+		return "", false, err
+	}
+	if err := c.offloadSubmit(&opts); err != nil {
+		return "", false, err
+	}
+	fillTraceFromContext(ctx, &opts)
 
 	// 应用 Submit 默认超时（若 ctx 没有 deadline）
 	if _, ok := ctx.Deadline(); !ok {
@@ -56,7 +91,7 @@ func (c *Client) SubmitTask(ctx context.Context, opts SubmitOptions) (runID stri
 		defer cancel()
 	}
 
-	creds := newSignedCreds(c.cfg.AppKey, c.cfg.AppSecret)
+	creds := newSignedCreds(c.cfg.AppKey, c.cfg.AppSecret, c.clock.now())
 	resp, err := c.schedCli.SubmitTask(ctx, &pb.SubmitTaskRequest{
 		AppName:         c.cfg.AppName,
 		AppKey:          c.cfg.AppKey,
@@ -69,6 +104,8 @@ func (c *Client) SubmitTask(ctx context.Context, opts SubmitOptions) (runID stri
 		DedupeWindowSec: opts.DedupeWindowSec,
 		TraceId:         opts.TraceID,
 		SpanId:          opts.SpanID,
+		RunAt:           unixMilliOrZero(opts.RunAt),
+		Priority:        opts.Priority,
 	})
 	if err != nil {
 		return "", false, fmt.Errorf("submit task: %w", err)
@@ -76,6 +113,14 @@ func (c *Client) SubmitTask(ctx context.Context, opts SubmitOptions) (runID stri
 	return resp.RunId, resp.Dedup, nil
 }
 
+// unixMilliOrZero 零值 time.Time 映射为 0（UnixMilli 对零值返回负数）。
+func unixMilliOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
 // GetRun 查询 run 状态（透传 SchedulerService.GetRun，方便业务方简单封装）。
 func (c *Client) GetRun(ctx context.Context, runID string) (*pb.Run, error) {
 	if c.schedCli == nil {
@@ -100,7 +145,8 @@ func (c *Client) GetRun(ctx context.Context, runID string) (*pb.Run, error) {
 //
 // 用途：业务方启动时一次性把代码里 RegisterHandler 的 jobName 同步到 scheduler 元数据。
 // 注：EnsureJob 不会修改已存在的 Job 配置（避免覆盖运维通过 UI 做的调整）。
-// 如需强制更新配置，调用 UpdateJob（未来可补 admin 客户端封装）。
+// 发起 RPC 前经 ValidateJob 校验触发配置，cron_expr / timezone 等非法时返回 ErrInvalidJobDef。
+// 需要以代码为准维护完整配置时改用 SyncJobs（声明式同步，支持更新与 prune）。
 //
 // 返回值：
 //   - created=true 表示本次新建；created=false 表示已存在
@@ -111,6 +157,9 @@ func (c *Client) EnsureJob(ctx context.Context, job *pb.Job) (created bool, err
 	if job == nil || job.JobName == "" {
 		return false, errors.New("scheduler: EnsureJob job.job_name required")
 	}
+	if err := ValidateJob(job); err != nil {
+		return false, err
+	}
 	if _, ok := ctx.Deadline(); !ok {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithTimeout(ctx, c.cfg.SubmitTimeout)
@@ -0,0 +1,149 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// submitBatchMax 单次 SubmitTasks 的条目上限，与服务端一致；SubmitBatch 超出时自动分批。
+const submitBatchMax = 500
+
+// SubmitResult SubmitBatch 单条任务的提交结果，与入参一一对应。
+type SubmitResult struct {
+	// RunID 成功时 scheduler 分配的 run_id
+	RunID string
+	// Dedup 命中幂等去重，RunID 为已存在的 run
+	Dedup bool
+	// Err 该条提交失败的原因：入参错误，或包装服务端返回的 gRPC status（可用 status.Code 判断）
+	Err error
+}
+
+// SubmitBatch 批量提交 API 任务，对应 SchedulerService.SubmitTasks：整批只签名一次、每 500 条一次往返，
+// 适合按设备扇出等高频提交场景。
+//
+// 返回的 results 与 opts 一一对应，单条失败（入参错误、job 不存在、配额等）只体现在对应条目的 Err 上。
+// err 非 nil 表示某一批整体失败（连接不可达、鉴权失败、ctx 取消等）：此前各批的结果仍有效，
+// 该批及之后未提交的条目 Err 均为该错误。
+//
+// 服务端未实现 SubmitTasks（codes.Unimplemented）时降级为逐条 SubmitTask。
+// 超时：每批使用 Config.SubmitTimeout（ctx 没有 deadline 时）。
+func (c *Client) SubmitBatch(ctx context.Context, opts []SubmitOptions) (results []SubmitResult, err error) {
+	if c.schedCli == nil {
+		return nil, ErrSubmitNotConnected
+	}
+	results = make([]SubmitResult, len(opts))
+	now := time.Now()
+	// 不修改调用方的切片：Delay 换算、trace 填充写入副本
+	opts = slices.Clone(opts)
+	// 通过校验的条目下标
+	valid := make([]int, 0, len(opts))
+	items := make([]*pb.SubmitTaskItem, len(opts))
+	for i := range opts {
+		o := &opts[i]
+		if o.JobName == "" {
+			results[i].Err = errors.New("scheduler: SubmitOptions.JobName required")
+			continue
+		}
+		if err := o.resolveSchedule(now); err != nil {
+			results[i].Err = err
+			continue
+		}
+		if err := c.offloadSubmit(o); err != nil {
+			results[i].Err = err
+			continue
+		}
+		fillTraceFromContext(ctx, o)
+		items[i] = &pb.SubmitTaskItem{
+			JobName:         o.JobName,
+			BizKey:          o.BizKey,
+			Payload:         o.Payload,
+			DedupeWindowSec: o.DedupeWindowSec,
+			TraceId:         o.TraceID,
+			SpanId:          o.SpanID,
+			RunAt:           unixMilliOrZero(o.RunAt),
+			Priority:        o.Priority,
+		}
+		valid = append(valid, i)
+	}
+
+	for len(valid) > 0 {
+		chunk := valid[:min(len(valid), submitBatchMax)]
+		if c.batchUnsupported.Load() {
+			err = c.submitEach(ctx, opts, chunk, results)
+		} else {
+			err = c.submitChunk(ctx, opts, items, chunk, results)
+		}
+		if err != nil {
+			for _, i := range valid {
+				if results[i].RunID == "" && results[i].Err == nil {
+					results[i].Err = err
+				}
+			}
+			return results, err
+		}
+		valid = valid[len(chunk):]
+	}
+	return results, nil
+}
+
+// submitChunk 以一次 SubmitTasks 提交 idx 对应的条目；服务端未实现时标记 batchUnsupported 并改为逐条提交。
+func (c *Client) submitChunk(ctx context.Context, opts []SubmitOptions, items []*pb.SubmitTaskItem, idx []int, results []SubmitResult) error {
+	req := &pb.SubmitTasksRequest{
+		AppName: c.cfg.AppName,
+		AppKey:  c.cfg.AppKey,
+		Tasks:   make([]*pb.SubmitTaskItem, len(idx)),
+	}
+	for k, i := range idx {
+		req.Tasks[k] = items[i]
+	}
+	creds := newSignedCreds(c.cfg.AppKey, c.cfg.AppSecret, c.clock.now())
+	req.Signature, req.Nonce, req.Ts = creds.Signature, creds.Nonce, creds.Ts
+
+	callCtx := ctx
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, c.cfg.SubmitTimeout)
+		defer cancel()
+	}
+	resp, err := c.schedCli.SubmitTasks(callCtx, req)
+	if status.Code(err) == codes.Unimplemented {
+		// 老版本服务端：本 Client 之后的批量提交直接逐条发送
+		c.batchUnsupported.Store(true)
+		return c.submitEach(ctx, opts, idx, results)
+	}
+	if err != nil {
+		return fmt.Errorf("submit tasks: %w", err)
+	}
+	if len(resp.Results) != len(idx) {
+		return fmt.Errorf("submit tasks: got %d results for %d tasks", len(resp.Results), len(idx))
+	}
+	for k, i := range idx {
+		r := resp.Results[k]
+		if r.Code != int32(codes.OK) {
+			results[i].Err = fmt.Errorf("submit task: %w", status.Error(codes.Code(r.Code), r.Error))
+			continue
+		}
+		results[i].RunID, results[i].Dedup = r.RunId, r.Dedup
+	}
+	return nil
+}
+
+// submitEach 逐条 SubmitTask 提交 idx 对应的条目（SubmitTasks 不可用时的降级路径）；
+// 遇到可重试错误（scheduler 不可达）即停止并返回该错误，避免无效请求风暴。
+func (c *Client) submitEach(ctx context.Context, opts []SubmitOptions, idx []int, results []SubmitResult) error {
+	for _, i := range idx {
+		runID, dedup, err := c.SubmitTask(ctx, opts[i])
+		if err != nil && isRetriableSubmitErr(err) {
+			return err
+		}
+		results[i] = SubmitResult{RunID: runID, Dedup: dedup, Err: err}
+	}
+	return nil
+}
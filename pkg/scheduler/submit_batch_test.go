@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIntegration_SubmitBatchPerItemResults(t *testing.T) {
+	srv, c := startFakeClient(t, schedulertest.Options{}, nil, nil)
+	ctx := waitCtx(t)
+
+	opts := []SubmitOptions{
+		{JobName: "device.push", BizKey: "d-1", DedupeWindowSec: 60},
+		{BizKey: "missing-job"},
+		{JobName: "device.push", BizKey: "d-1", DedupeWindowSec: 60},
+		{JobName: "device.push", BizKey: "d-2", Delay: time.Minute, Priority: pb.Priority_PRIORITY_LOW},
+	}
+	results, err := c.SubmitBatch(ctx, opts)
+	if err != nil {
+		t.Fatalf("SubmitBatch: %v", err)
+	}
+	if len(results) != len(opts) {
+		t.Fatalf("results=%d want %d", len(results), len(opts))
+	}
+	if r := results[0]; r.Err != nil || r.RunID == "" || r.Dedup {
+		t.Fatalf("item 0: %+v", r)
+	}
+	if results[1].Err == nil || results[1].RunID != "" {
+		t.Fatalf("item 1 without JobName must fail locally: %+v", results[1])
+	}
+	if r := results[2]; r.Err != nil || !r.Dedup || r.RunID != results[0].RunID {
+		t.Fatalf("item 2 should hit dedup of item 0: %+v", r)
+	}
+	if opts[3].Delay != time.Minute || !opts[3].RunAt.IsZero() {
+		t.Fatalf("SubmitBatch must not modify caller opts: %+v", opts[3])
+	}
+	if srv.BatchSubmits() != 1 {
+		t.Fatalf("batch submits=%d want 1", srv.BatchSubmits())
+	}
+	run, err := c.GetRun(ctx, results[3].RunID)
+	if err != nil || run.NextRunAt == 0 || run.Priority != pb.Priority_PRIORITY_LOW {
+		t.Fatalf("delayed item run=%v err=%v", run, err)
+	}
+}
+
+func TestIntegration_SubmitBatchSplitsChunks(t *testing.T) {
+	srv, c := startFakeClient(t, schedulertest.Options{}, nil, nil)
+	ctx := waitCtx(t)
+
+	opts := make([]SubmitOptions, submitBatchMax+1)
+	for i := range opts {
+		opts[i] = SubmitOptions{JobName: "device.push", BizKey: fmt.Sprintf("d-%d", i)}
+	}
+	results, err := c.SubmitBatch(ctx, opts)
+	if err != nil {
+		t.Fatalf("SubmitBatch: %v", err)
+	}
+	for i, r := range results {
+		if r.Err != nil || r.RunID == "" {
+			t.Fatalf("item %d: %+v", i, r)
+		}
+	}
+	if srv.BatchSubmits() != 2 || len(srv.Runs()) != len(opts) {
+		t.Fatalf("batch submits=%d runs=%d", srv.BatchSubmits(), len(srv.Runs()))
+	}
+}
+
+func TestIntegration_SubmitBatchWholeCallError(t *testing.T) {
+	srv, c := startFakeClient(t, schedulertest.Options{}, nil, nil)
+	ctx := waitCtx(t)
+
+	srv.FailSubmit(status.Error(codes.Unavailable, "scheduler down"))
+	results, err := c.SubmitBatch(ctx, []SubmitOptions{{JobName: "a"}, {}, {JobName: "b"}})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("err=%v, want Unavailable", err)
+	}
+	if status.Code(results[0].Err) != codes.Unavailable || status.Code(results[2].Err) != codes.Unavailable {
+		t.Fatalf("unsent items must carry the call error: %+v", results)
+	}
+	if results[1].Err == nil || status.Code(results[1].Err) == codes.Unavailable {
+		t.Fatalf("invalid item must keep its own error: %+v", results[1])
+	}
+}
+
+func TestIntegration_SubmitBatchFallsBackToSubmitTask(t *testing.T) {
+	srv, c := startFakeClient(t, schedulertest.Options{DisableSubmitTasks: true}, nil, nil)
+	ctx := waitCtx(t)
+
+	for range 2 {
+		results, err := c.SubmitBatch(ctx, []SubmitOptions{{JobName: "a"}, {JobName: "b"}})
+		if err != nil {
+			t.Fatalf("SubmitBatch: %v", err)
+		}
+		for i, r := range results {
+			if r.Err != nil || r.RunID == "" {
+				t.Fatalf("item %d: %+v", i, r)
+			}
+		}
+	}
+	if !c.batchUnsupported.Load() || srv.BatchSubmits() != 0 || len(srv.Runs()) != 4 {
+		t.Fatalf("unsupported=%v batch submits=%d runs=%d", c.batchUnsupported.Load(), srv.BatchSubmits(), len(srv.Runs()))
+	}
+}
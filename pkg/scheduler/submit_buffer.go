@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/sidchai/compkg/pkg/logger"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -32,6 +34,8 @@ type submitBuffer struct {
 	mu     sync.Mutex
 	queue  []bufferedTask
 	notify chan struct{} // 入队事件信号，唤醒 retry worker 立即扫一次
+
+	dropped atomic.Int64 // putBack 超出容量丢弃的最旧任务数
 }
 
 func newSubmitBuffer(capacity int) *submitBuffer {
@@ -85,6 +89,7 @@ func (b *submitBuffer) putBack(items []bufferedTask) {
 	// 容量保护：超过 cap 时丢最旧的，避免无限累积导致内存爆掉
 	merged := append(items, b.queue...)
 	if len(merged) > b.cap {
+		b.dropped.Add(int64(len(merged) - b.cap))
 		merged = merged[len(merged)-b.cap:]
 	}
 	b.queue = merged
@@ -108,6 +113,7 @@ func (b *submitBuffer) Len() int {
 //   - 队列已满且未启用磁盘 spill → err=ErrLocalBufferFull
 //   - 启用磁盘 spill 后内存满会落盘 → err=nil, queued=true
 //   - 入参校验失败 → err 非 nil（不入队）
+//   - Delay 在入队前换算为绝对 RunAt，重放时不会因排队时长被再次推迟
 //
 // 与 SubmitTask 的取舍：
 //   - 需要立即拿到 RunID / 同步幂等结果 → 用 SubmitTask
@@ -119,6 +125,15 @@ func (c *Client) EnqueueTask(ctx context.Context, opts SubmitOptions) (queued bo
 	if opts.JobName == "" {
 		return false, errors.New("scheduler: SubmitOptions.JobName required")
 	}
+	if err := opts.resolveSchedule(time.Now()); err != nil {
+		return false, err
+	}
+	// 入队前固化 trace：后台重发用的是 rootCtx，届时已拿不到调用方 span
+	fillTraceFromContext(ctx, &opts)
+	// 入队前转存大 payload，缓冲 / spool 只保存引用信封；对象存储同样不可达时保留原文，重放时再试
+	if err := c.offloadSubmit(&opts); err != nil {
+		logger.Warnf("[scheduler-sdk] job=%s %v, buffer payload inline", opts.JobName, err)
+	}
 	if c.schedCli == nil {
 		// 未 Start：直接入队，等 Start 后 worker 启动再发
 		if !c.enqueueBufferedTask(bufferedTask{opts: opts, enqueuedAt: time.Now()}) {
@@ -172,10 +187,11 @@ func (c *Client) enqueueBufferedTask(t bufferedTask) bool {
 	if c.localBuffer.push(t) {
 		return true
 	}
-	if c.localSpool == nil {
+	if c.localSpool == nil || c.localSpool.push(t) != nil {
+		c.droppedTasks.Add(1)
 		return false
 	}
-	return c.localSpool.push(t) == nil
+	return true
 }
 
 // isRetriableSubmitErr 判定 SubmitTask 错误是否属于"scheduler 端暂时不可达"，
@@ -222,10 +238,10 @@ func (c *Client) runBufferRetryLoop() {
 // bufferDone 兜底关闭信号；当前实现仅依赖 rootCtx 即可退出，留作扩展点。
 func (c *Client) bufferDone() {}
 
-// flushBufferOnce 单轮重发：取最多 batch 条，逐条发送；失败的整体回插队首。
+// flushBufferOnce 单轮重发：取最多 batch 条，经 SubmitBatch 一次提交；可重试失败的回插队首。
 //
-// 注意：一旦遇到第一条 retriable 失败，立即停止本轮（说明 scheduler 仍未恢复），
-// 把剩余未尝试 + 失败那条一起回插，避免无效请求风暴。
+// 注意：只要有一条 retriable 失败（说明 scheduler 仍未恢复），本轮不再处理磁盘 spill，
+// 失败的条目按原顺序回插，避免无效请求风暴。
 func (c *Client) flushBufferOnce() {
 	if c.schedCli == nil {
 		return
@@ -236,22 +252,24 @@ func (c *Client) flushBufferOnce() {
 		return
 	}
 
+	opts := make([]SubmitOptions, len(batch))
 	for i, t := range batch {
-		ctx, cancel := context.WithTimeout(c.rootCtx, c.cfg.SubmitTimeout)
-		_, _, err := c.SubmitTask(ctx, t.opts)
-		cancel()
-		if err == nil {
-			continue
-		}
-		if isRetriableSubmitErr(err) {
-			// scheduler 仍不可达：把当前失败 + 后续未尝试任务整体回插
-			t.attempts++
-			remain := append([]bufferedTask{t}, batch[i+1:]...)
-			c.localBuffer.putBack(remain)
-			return
+		opts[i] = t.opts
+	}
+	results, _ := c.SubmitBatch(c.rootCtx, opts)
+	var remain []bufferedTask
+	for i, r := range results {
+		// 非 retriable（业务错误）：丢弃该条；业务方应通过 SubmitTask 自查，buffer 不会无限重试 InvalidArgument 之类
+		if isRetriableSubmitErr(r.Err) {
+			batch[i].attempts++
+			remain = append(remain, batch[i])
+		} else if r.Err != nil {
+			c.droppedTasks.Add(1)
 		}
-		// 非 retriable（业务错误）：丢弃该条，继续处理后续
-		// 业务方应通过 SubmitTask 自查；buffer 不会无限重试 InvalidArgument 之类
+	}
+	if len(remain) > 0 {
+		c.localBuffer.putBack(remain)
+		return
 	}
 
 	// 内存队列清空后再处理磁盘 spill，保持 L1 → L2 的双级顺序；
@@ -259,7 +277,7 @@ func (c *Client) flushBufferOnce() {
 	c.flushSpoolOnce()
 }
 
-// flushSpoolOnce 单轮重放磁盘 spill：按 FIFO 读取 batch 条，成功后 ack 删除文件。
+// flushSpoolOnce 单轮重放磁盘 spill：按 FIFO 读取 batch 条经 SubmitBatch 提交，成功后 ack 删除文件。
 func (c *Client) flushSpoolOnce() {
 	if c.localSpool == nil || c.schedCli == nil {
 		return
@@ -268,18 +286,20 @@ func (c *Client) flushSpoolOnce() {
 	if err != nil || len(batch) == 0 {
 		return
 	}
-	for _, t := range batch {
-		ctx, cancel := context.WithTimeout(c.rootCtx, c.cfg.SubmitTimeout)
-		_, _, submitErr := c.SubmitTask(ctx, t.Opts)
-		cancel()
-		if submitErr == nil {
-			_ = c.localSpool.ack(t.Seq)
+	opts := make([]SubmitOptions, len(batch))
+	for i, t := range batch {
+		opts[i] = t.Opts
+	}
+	results, _ := c.SubmitBatch(c.rootCtx, opts)
+	for i, r := range results {
+		if isRetriableSubmitErr(r.Err) {
+			// scheduler 仍不可达：文件保留在磁盘，下一轮重放
 			continue
 		}
-		if isRetriableSubmitErr(submitErr) {
-			return
+		// 成功，或非可重试错误说明任务参数/业务状态不合法，删除该 spill 文件避免无限重放。
+		if r.Err != nil {
+			c.droppedTasks.Add(1)
 		}
-		// 非可重试错误说明任务参数/业务状态不合法，删除该 spill 文件避免无限重放。
-		_ = c.localSpool.ack(t.Seq)
+		_ = c.localSpool.ack(batch[i].Seq)
 	}
 }
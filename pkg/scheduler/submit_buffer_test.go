@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -69,7 +70,7 @@ func TestIsRetriableSubmitErr(t *testing.T) {
 
 func TestSubmitSpool_PushDrainAckReplay(t *testing.T) {
 	dir := t.TempDir()
-	spool, err := newSubmitSpool(dir, 1024*1024)
+	spool, err := newSubmitSpool(dir, 1024*1024, nil)
 	if err != nil {
 		t.Fatalf("newSubmitSpool: %v", err)
 	}
@@ -84,7 +85,7 @@ func TestSubmitSpool_PushDrainAckReplay(t *testing.T) {
 	if got := spool.len(); got != 2 {
 		t.Fatalf("spool len=%d want 2", got)
 	}
-	reloaded, err := newSubmitSpool(dir, 1024*1024)
+	reloaded, err := newSubmitSpool(dir, 1024*1024, nil)
 	if err != nil {
 		t.Fatalf("reload spool: %v", err)
 	}
@@ -105,7 +106,7 @@ func TestSubmitSpool_PushDrainAckReplay(t *testing.T) {
 
 func TestSubmitSpool_MaxBytesEvictsOldest(t *testing.T) {
 	dir := t.TempDir()
-	spool, err := newSubmitSpool(dir, 430)
+	spool, err := newSubmitSpool(dir, 430, nil)
 	if err != nil {
 		t.Fatalf("newSubmitSpool: %v", err)
 	}
@@ -159,3 +160,82 @@ func TestClient_EnqueueFallsBackToDiskSpillWhenMemoryFull(t *testing.T) {
 		t.Fatalf("counts buffer=%d spill=%d want 1/1", c.BufferedCount(), c.SpilledCount())
 	}
 }
+
+func TestSubmitOptions_ResolveSchedule(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	at := now.Add(time.Hour)
+	cases := []struct {
+		name    string
+		opts    SubmitOptions
+		want    time.Time
+		wantErr bool
+	}{
+		{"immediate", SubmitOptions{}, time.Time{}, false},
+		{"run_at", SubmitOptions{RunAt: at}, at, false},
+		{"delay", SubmitOptions{Delay: 10 * time.Minute}, now.Add(10 * time.Minute), false},
+		{"negative delay", SubmitOptions{Delay: -time.Second}, time.Time{}, true},
+		{"both", SubmitOptions{RunAt: at, Delay: time.Second}, time.Time{}, true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			err := opts.resolveSchedule(now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err=%v wantErr=%v", err, tt.wantErr)
+			}
+			if err == nil && (!opts.RunAt.Equal(tt.want) || opts.Delay != 0) {
+				t.Fatalf("RunAt=%v Delay=%v, want RunAt=%v", opts.RunAt, opts.Delay, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_EnqueueKeepsScheduledTimeAcrossSpill(t *testing.T) {
+	dir := t.TempDir()
+	c, err := New(Config{
+		Endpoint:                     "x:9090",
+		AppName:                      "a",
+		AppKey:                       "k",
+		AppSecret:                    "s",
+		LocalBufferEnabled:           true,
+		LocalBufferCapacity:          1,
+		LocalBufferDiskSpillEnabled:  true,
+		LocalBufferDiskSpillDir:      dir,
+		LocalBufferDiskSpillMaxBytes: 1024 * 1024,
+		LocalBufferRetryInterval:     time.Hour,
+		LocalBufferRetryBatch:        10,
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	before := time.Now()
+	if _, err := c.EnqueueTask(context.Background(), SubmitOptions{JobName: "job-a", Delay: 10 * time.Minute}); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	at := time.Date(2026, 1, 2, 3, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	if _, err := c.EnqueueTask(context.Background(), SubmitOptions{JobName: "job-b", RunAt: at, Priority: pb.Priority_PRIORITY_HIGH}); err != nil {
+		t.Fatalf("enqueue spill: %v", err)
+	}
+	if _, err := c.EnqueueTask(context.Background(), SubmitOptions{JobName: "job-c", RunAt: at, Delay: time.Second}); err == nil {
+		t.Fatal("RunAt with Delay must be rejected before buffering")
+	}
+
+	buffered := c.localBuffer.drain(10)
+	if len(buffered) != 1 || buffered[0].opts.Delay != 0 ||
+		buffered[0].opts.RunAt.Before(before.Add(10*time.Minute)) || buffered[0].opts.RunAt.After(time.Now().Add(10*time.Minute)) {
+		t.Fatalf("delay not resolved to absolute RunAt: %#v", buffered)
+	}
+
+	// 模拟进程重启后从磁盘重放
+	reloaded, err := newSubmitSpool(dir, 1024*1024, nil)
+	if err != nil {
+		t.Fatalf("reload spool: %v", err)
+	}
+	items, err := reloaded.drain(10)
+	if err != nil || len(items) != 1 {
+		t.Fatalf("drain items=%d err=%v", len(items), err)
+	}
+	if !items[0].Opts.RunAt.Equal(at) || items[0].Opts.Priority != pb.Priority_PRIORITY_HIGH {
+		t.Fatalf("spooled schedule lost: RunAt=%v Priority=%v", items[0].Opts.RunAt, items[0].Opts.Priority)
+	}
+}
@@ -10,12 +10,19 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
 )
 
 const (
 	defaultDiskSpillDirName  = "scheduler-spool"
 	defaultDiskSpillMaxBytes = int64(100 * 1024 * 1024)
+
+	spoolExt        = ".spool"
+	spoolLegacyExt  = ".json" // 旧版明文 JSON 记录，加载时迁移为 .spool
+	spoolCorruptExt = ".corrupt"
 )
 
 // ErrLocalBufferSpillFull 表示内存队列满且磁盘 spill 也因容量限制无法接纳新任务。
@@ -23,7 +30,7 @@ var ErrLocalBufferSpillFull = errors.New("scheduler: local buffer spill full")
 
 // spoolTask 是落盘后的待重放任务记录。
 //
-// 文件名使用单调递增序号承载顺序，文件内容保留 SubmitOptions 和排障字段；
+// 文件名使用单调递增序号承载顺序，文件内容为 JSON 经 spoolCodec 封装（可选压缩 / 加密 + CRC），保留 SubmitOptions 和排障字段；
 // 成功提交 scheduler 后删除该文件，进程重启时按序号升序扫描即可恢复 FIFO 语义。
 type spoolTask struct {
 	Seq        int64         `json:"seq"`
@@ -38,17 +45,23 @@ type spoolTask struct {
 // 设计约束：
 //   - 仅服务 EnqueueTask；SubmitTask 同步语义保持不变
 //   - 标准库文件队列，不引入 badger/bolt 依赖，降低 compkg 公共包升级风险
-//   - 每条任务一个 JSON 文件，写入临时文件后原子 rename，避免进程崩溃留下半文件
+//   - 每条任务一个记录文件，写入临时文件后原子 rename；仍出现的半截 / 损坏记录（CRC 不符、无法解密）
+//     改名为 .corrupt 隔离后跳过，不阻塞其余任务重放
 //   - currentBytes 超过 maxBytes 前会先淘汰最旧任务；单条任务大于 maxBytes 直接拒绝
 //   - replay 时先取最旧 N 条，提交成功后 delete，失败时保留文件等待下一轮
+//   - 旧版明文 .json 记录在加载时按当前 codec 重写为 .spool（保留序号），之后删除原文件
 type submitSpool struct {
 	dir      string
 	maxBytes int64
+	codec    *spoolCodec
 
 	mu           sync.Mutex
 	nextSeq      int64
 	currentBytes int64
 	files        []spoolFile
+
+	evicted   atomic.Int64 // 超出 maxBytes 被淘汰的任务数
+	corrupted atomic.Int64 // 无法解码被隔离的任务数
 }
 
 type spoolFile struct {
@@ -57,7 +70,8 @@ type spoolFile struct {
 	size int64
 }
 
-func newSubmitSpool(dir string, maxBytes int64) (*submitSpool, error) {
+// newSubmitSpool 打开 dir 下的磁盘队列并恢复已有记录；codec 为 nil 时不压缩不加密。
+func newSubmitSpool(dir string, maxBytes int64, codec *spoolCodec) (*submitSpool, error) {
 	if dir == "" {
 		dir = filepath.Join(os.TempDir(), defaultDiskSpillDirName)
 	}
@@ -67,7 +81,7 @@ func newSubmitSpool(dir string, maxBytes int64) (*submitSpool, error) {
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return nil, fmt.Errorf("create scheduler spool dir: %w", err)
 	}
-	s := &submitSpool{dir: dir, maxBytes: maxBytes, nextSeq: time.Now().UnixNano()}
+	s := &submitSpool{dir: dir, maxBytes: maxBytes, codec: codec, nextSeq: time.Now().UnixNano()}
 	if err := s.reloadLocked(); err != nil {
 		return nil, err
 	}
@@ -102,6 +116,9 @@ func (s *submitSpool) push(t bufferedTask) error {
 	if err != nil {
 		return fmt.Errorf("marshal scheduler spool task with size: %w", err)
 	}
+	if data, err = s.codec.seal(data); err != nil {
+		return err
+	}
 	size := int64(len(data))
 	if size > s.maxBytes {
 		return ErrLocalBufferSpillFull
@@ -113,23 +130,60 @@ func (s *submitSpool) push(t bufferedTask) error {
 		}
 		s.currentBytes -= oldest.size
 		s.files = s.files[1:]
+		s.evicted.Add(1)
 	}
 	if s.currentBytes+size > s.maxBytes {
 		return ErrLocalBufferSpillFull
 	}
 
-	finalPath := filepath.Join(s.dir, fmt.Sprintf("%020d.json", seq))
+	finalPath, err := s.writeRecord(seq, data)
+	if err != nil {
+		return err
+	}
+	s.files = append(s.files, spoolFile{seq: seq, path: finalPath, size: size})
+	s.currentBytes += size
+	return nil
+}
+
+// writeRecord 先写临时文件再原子 rename 为 {seq}.spool。
+func (s *submitSpool) writeRecord(seq int64, rec []byte) (string, error) {
+	finalPath := filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, spoolExt))
 	tmpPath := finalPath + ".tmp"
-	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
-		return fmt.Errorf("write scheduler spool tmp: %w", err)
+	if err := os.WriteFile(tmpPath, rec, 0o600); err != nil {
+		return "", fmt.Errorf("write scheduler spool tmp: %w", err)
 	}
 	if err := os.Rename(tmpPath, finalPath); err != nil {
 		_ = os.Remove(tmpPath)
-		return fmt.Errorf("rename scheduler spool task: %w", err)
+		return "", fmt.Errorf("rename scheduler spool task: %w", err)
+	}
+	return finalPath, nil
+}
+
+// readRecord 读取并解码一条记录；记录损坏时返回包装 errSpoolCorrupt 的错误。
+func (s *submitSpool) readRecord(path string) (spoolTask, error) {
+	var st spoolTask
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return st, fmt.Errorf("read scheduler spool task %s: %w", path, err)
+	}
+	if strings.HasSuffix(path, spoolExt) {
+		if data, err = s.codec.open(data); err != nil {
+			return st, err
+		}
+	}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return st, fmt.Errorf("%w: decode: %v", errSpoolCorrupt, err)
+	}
+	return st, nil
+}
+
+// quarantine 把无法解码的记录改名为 .corrupt 留待排查，之后不再加载。
+func (s *submitSpool) quarantine(path string, cause error) {
+	s.corrupted.Add(1)
+	logger.Warnf("[scheduler-sdk] skip corrupted spool record %s: %v", path, cause)
+	if err := os.Rename(path, path+spoolCorruptExt); err != nil && !errors.Is(err, os.ErrNotExist) {
+		_ = os.Remove(path)
 	}
-	s.files = append(s.files, spoolFile{seq: seq, path: finalPath, size: size})
-	s.currentBytes += size
-	return nil
 }
 
 // drain 读取最多 max 条磁盘任务但不删除文件；调用方提交成功后必须 ack。
@@ -146,17 +200,27 @@ func (s *submitSpool) drain(max int) ([]spoolTask, error) {
 		max = len(s.files)
 	}
 	out := make([]spoolTask, 0, max)
-	for _, f := range s.files[:max] {
-		data, err := os.ReadFile(f.path)
-		if err != nil {
-			return nil, fmt.Errorf("read scheduler spool task %s: %w", f.path, err)
+	kept := s.files[:0]
+	for i, f := range s.files {
+		if i >= max {
+			kept = append(kept, f)
+			continue
 		}
-		var st spoolTask
-		if err := json.Unmarshal(data, &st); err != nil {
-			return nil, fmt.Errorf("decode scheduler spool task %s: %w", f.path, err)
+		st, err := s.readRecord(f.path)
+		switch {
+		case err == nil:
+			out = append(out, st)
+			kept = append(kept, f)
+		case errors.Is(err, errSpoolCorrupt):
+			s.quarantine(f.path, err)
+			s.currentBytes -= f.size
+		case errors.Is(err, os.ErrNotExist):
+			s.currentBytes -= f.size
+		default:
+			return nil, err
 		}
-		out = append(out, st)
 	}
+	s.files = kept
 	return out, nil
 }
 
@@ -199,6 +263,7 @@ func (s *submitSpool) bytes() int64 {
 	return s.currentBytes
 }
 
+// reloadLocked 扫描目录恢复待重放任务：清理残留 .tmp、隔离损坏记录、迁移旧版 .json，超出 maxBytes 时淘汰最旧任务。
 func (s *submitSpool) reloadLocked() error {
 	entries, err := os.ReadDir(s.dir)
 	if err != nil {
@@ -207,20 +272,44 @@ func (s *submitSpool) reloadLocked() error {
 	files := make([]spoolFile, 0, len(entries))
 	var total int64
 	var maxSeq int64
+	// 迁移中途崩溃会同时留下 {seq}.json 与 {seq}.spool：.json 排序在前，重新迁移后跳过同序号的 .spool
+	seen := make(map[int64]bool, len(entries))
 	for _, e := range entries {
-		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+		name := e.Name()
+		path := filepath.Join(s.dir, name)
+		if e.IsDir() {
 			continue
 		}
-		seqText := strings.TrimSuffix(e.Name(), ".json")
-		seq, err := strconv.ParseInt(seqText, 10, 64)
-		if err != nil {
+		if strings.HasSuffix(name, ".tmp") {
+			// rename 之前崩溃留下的临时文件，对应任务未入队成功
+			_ = os.Remove(path)
+			continue
+		}
+		ext := filepath.Ext(name)
+		if ext != spoolExt && ext != spoolLegacyExt {
+			continue
+		}
+		seq, err := strconv.ParseInt(strings.TrimSuffix(name, ext), 10, 64)
+		if err != nil || seen[seq] {
+			continue
+		}
+		if _, err := s.readRecord(path); err != nil {
+			if !errors.Is(err, errSpoolCorrupt) {
+				return err
+			}
+			s.quarantine(path, err)
 			continue
 		}
-		path := filepath.Join(s.dir, e.Name())
-		info, err := e.Info()
+		if ext == spoolLegacyExt {
+			if path, err = s.migrateLegacy(seq, path); err != nil {
+				return err
+			}
+		}
+		info, err := os.Stat(path)
 		if err != nil {
 			return fmt.Errorf("stat scheduler spool file %s: %w", path, err)
 		}
+		seen[seq] = true
 		files = append(files, spoolFile{seq: seq, path: path, size: info.Size()})
 		total += info.Size()
 		if seq > maxSeq {
@@ -240,6 +329,27 @@ func (s *submitSpool) reloadLocked() error {
 		}
 		s.currentBytes -= oldest.size
 		s.files = s.files[1:]
+		s.evicted.Add(1)
 	}
 	return nil
 }
+
+// migrateLegacy 把旧版明文 .json 记录按当前 codec 重写为同序号的 .spool 并删除原文件，返回新路径。
+func (s *submitSpool) migrateLegacy(seq int64, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read scheduler spool task %s: %w", path, err)
+	}
+	rec, err := s.codec.seal(data)
+	if err != nil {
+		return "", err
+	}
+	newPath, err := s.writeRecord(seq, rec)
+	if err != nil {
+		return "", err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("remove migrated scheduler spool task %s: %w", path, err)
+	}
+	return newPath, nil
+}
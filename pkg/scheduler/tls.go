@@ -0,0 +1,168 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// tlsEnabled 是否以 TLS 连接：显式开启，或配置了 CA / 客户端证书 / TLSConfig。
+func (c *Config) tlsEnabled() bool {
+	return c.TLSEnabled || c.TLSCAFile != "" || c.TLSCertFile != "" || c.TLSConfig != nil
+}
+
+// transportCredentials 按 cfg 选择传输凭据：TransportCredentials > TLS > 明文；本地模式经 bufconn 直连，始终明文。
+func transportCredentials(cfg *Config) (credentials.TransportCredentials, error) {
+	if cfg.LocalMode {
+		return insecure.NewCredentials(), nil
+	}
+	if cfg.TransportCredentials != nil {
+		return cfg.TransportCredentials, nil
+	}
+	if !cfg.tlsEnabled() {
+		return insecure.NewCredentials(), nil
+	}
+	base := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.TLSConfig != nil {
+		base = cfg.TLSConfig.Clone()
+	}
+	if cfg.TLSServerName != "" {
+		base.ServerName = cfg.TLSServerName
+	}
+	if cfg.TLSInsecureSkipVerify {
+		base.InsecureSkipVerify = true
+	}
+	if cfg.TLSCAFile == "" && cfg.TLSCertFile == "" {
+		return credentials.NewTLS(base), nil
+	}
+
+	files := &tlsFiles{
+		caFile:   cfg.TLSCAFile,
+		certFile: cfg.TLSCertFile,
+		keyFile:  cfg.TLSKeyFile,
+		interval: cfg.TLSReloadInterval,
+	}
+	if err := files.reload(true); err != nil {
+		return nil, err
+	}
+	files.checkedAt = time.Now()
+	if cfg.TLSCertFile != "" {
+		base.Certificates = nil
+		base.GetClientCertificate = files.clientCertificate
+	}
+	return &reloadingTLS{TransportCredentials: credentials.NewTLS(base), base: base, files: files}, nil
+}
+
+// reloadingTLS 每次握手按最新加载的 CA 构造 TLS 凭据，CA 轮换后新建连接 / 重连即生效；
+// 服务端主机名按连接的 authority（多副本时为各自地址）校验，TLSServerName 非空时以其为准。
+type reloadingTLS struct {
+	credentials.TransportCredentials // credentials.NewTLS(base)：Info / ServerHandshake 等
+	base                             *tls.Config
+	files                            *tlsFiles
+}
+
+func (r *reloadingTLS) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	cfg := r.base.Clone()
+	if roots, _ := r.files.maybeReload(); roots != nil {
+		cfg.RootCAs = roots
+	}
+	return credentials.NewTLS(cfg).ClientHandshake(ctx, authority, rawConn)
+}
+
+func (r *reloadingTLS) Clone() credentials.TransportCredentials {
+	return &reloadingTLS{TransportCredentials: r.TransportCredentials.Clone(), base: r.base.Clone(), files: r.files}
+}
+
+// OverrideServerName 实现 credentials.TransportCredentials（grpc 已不再调用）。
+func (r *reloadingTLS) OverrideServerName(name string) error {
+	r.base.ServerName = name
+	return nil
+}
+
+// tlsFiles CA 与客户端证书文件的热加载：每次 TLS 握手前检查文件 mtime（间隔不小于 interval），
+// 变化时重新加载；加载失败（如证书与私钥只更新了一半）时保留旧值，下个间隔重试。
+type tlsFiles struct {
+	caFile, certFile, keyFile string
+	interval                  time.Duration
+
+	mu                     sync.Mutex
+	checkedAt              time.Time
+	caMod, certMod, keyMod time.Time
+	roots                  *x509.CertPool
+	cert                   *tls.Certificate
+}
+
+// reload 重新加载 mtime 变化的文件；force 时无条件加载。调用方须持有 f.mu（初始化时除外）。
+func (f *tlsFiles) reload(force bool) error {
+	var errs []error
+	if f.caFile != "" {
+		if mod := fileModTime(f.caFile); force || !mod.Equal(f.caMod) {
+			pool, err := loadCertPool(f.caFile)
+			if err != nil {
+				errs = append(errs, err)
+			} else {
+				f.roots, f.caMod = pool, mod
+			}
+		}
+	}
+	if f.certFile != "" {
+		certMod, keyMod := fileModTime(f.certFile), fileModTime(f.keyFile)
+		if force || !certMod.Equal(f.certMod) || !keyMod.Equal(f.keyMod) {
+			cert, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("scheduler: load TLS client cert: %w", err))
+			} else {
+				f.cert, f.certMod, f.keyMod = &cert, certMod, keyMod
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// maybeReload 距上次检查超过 interval 时检查文件更新，返回当前的 CA 与客户端证书。
+func (f *tlsFiles) maybeReload() (*x509.CertPool, *tls.Certificate) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if now := time.Now(); now.Sub(f.checkedAt) >= f.interval {
+		f.checkedAt = now
+		if err := f.reload(false); err != nil {
+			logger.Warnf("[scheduler-sdk] reload TLS files failed, keep previous: %v", err)
+		}
+	}
+	return f.roots, f.cert
+}
+
+func (f *tlsFiles) clientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	_, cert := f.maybeReload()
+	return cert, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler: read TLS CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("scheduler: no certificate found in TLS CA %s", path)
+	}
+	return pool, nil
+}
+
+func fileModTime(path string) time.Time {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return fi.ModTime()
+}
@@ -0,0 +1,187 @@
+package scheduler
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	"google.golang.org/grpc/credentials"
+)
+
+// testPKI 测试用 CA 及其签发的证书。
+type testPKI struct {
+	t      *testing.T
+	dir    string
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caFile string
+	pool   *x509.CertPool
+	serial int64
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	p := &testPKI{t: t, dir: t.TempDir(), pool: x509.NewCertPool()}
+	p.caKey, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &p.caKey.PublicKey, p.caKey)
+	if err != nil {
+		t.Fatalf("create CA: %v", err)
+	}
+	p.ca, _ = x509.ParseCertificate(der)
+	p.pool.AddCert(p.ca)
+	p.caFile = filepath.Join(p.dir, "ca.pem")
+	writePEM(t, p.caFile, "CERTIFICATE", der)
+	return p
+}
+
+// issue 签发证书并写入 name.pem / name-key.pem，返回两个文件路径与 tls.Certificate。
+func (p *testPKI) issue(name string, server bool) (certFile, keyFile string, cert tls.Certificate) {
+	p.t.Helper()
+	p.serial++
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(p.serial + 1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if server {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.ca, &key.PublicKey, p.caKey)
+	if err != nil {
+		p.t.Fatalf("issue %s: %v", name, err)
+	}
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	certFile, keyFile = filepath.Join(p.dir, name+".pem"), filepath.Join(p.dir, name+"-key.pem")
+	writePEM(p.t, certFile, "CERTIFICATE", der)
+	writePEM(p.t, keyFile, "EC PRIVATE KEY", keyDER)
+	cert, err = tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		p.t.Fatalf("load %s: %v", name, err)
+	}
+	return certFile, keyFile, cert
+}
+
+func writePEM(t *testing.T, path, typ string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+func TestIntegration_MutualTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	_, _, serverCert := pki.issue("scheduler", true)
+	certFile, keyFile, _ := pki.issue("worker", false)
+	opts := schedulertest.Options{TLSConfig: &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pki.pool,
+	}}
+
+	srv, c := startFakeClient(t, opts, func(cfg *Config) {
+		cfg.TLSCAFile, cfg.TLSCertFile, cfg.TLSKeyFile = pki.caFile, certFile, keyFile
+	}, nil)
+	ctx := waitCtx(t)
+	if err := srv.WaitWorker(ctx); err != nil {
+		t.Fatalf("WaitWorker over mTLS: %v", err)
+	}
+	if _, _, err := c.SubmitTask(ctx, SubmitOptions{JobName: "device.push"}); err != nil {
+		t.Fatalf("SubmitTask over mTLS: %v", err)
+	}
+
+	// 不带客户端证书的连接被服务端拒绝
+	admin, err := NewAdminClient(ctx, Config{
+		Endpoint: srv.Addr(), AppName: srv.AppName(), AppKey: srv.AppKey(), AppSecret: srv.AppSecret(),
+		TLSCAFile: pki.caFile, DialTimeout: 300 * time.Millisecond,
+	})
+	if err == nil {
+		_ = admin.Close()
+		t.Fatal("dial without client cert must fail")
+	}
+}
+
+func TestTLSFiles_ReloadOnChange(t *testing.T) {
+	pki := newTestPKI(t)
+	certFile, keyFile, _ := pki.issue("worker", false)
+	files := &tlsFiles{certFile: certFile, keyFile: keyFile, caFile: pki.caFile, interval: time.Hour}
+	if err := files.reload(true); err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	files.checkedAt = time.Now()
+	commonName := func() string {
+		cert, _ := files.clientCertificate(nil)
+		leaf, _ := x509.ParseCertificate(cert.Certificate[0])
+		return leaf.Subject.CommonName
+	}
+
+	// 轮换：同一路径写入新证书
+	pki.issue("rotated", false)
+	for _, f := range [][2]string{{"rotated.pem", certFile}, {"rotated-key.pem", keyFile}} {
+		b, _ := os.ReadFile(filepath.Join(pki.dir, f[0]))
+		if err := os.WriteFile(f[1], b, 0o600); err != nil {
+			t.Fatalf("rotate: %v", err)
+		}
+		later := time.Now().Add(time.Second)
+		_ = os.Chtimes(f[1], later, later)
+	}
+	if cn := commonName(); cn != "worker" {
+		t.Fatalf("reloaded before interval: %s", cn)
+	}
+	files.checkedAt = time.Time{}
+	if cn := commonName(); cn != "rotated" {
+		t.Fatalf("after rotation cn=%s, want rotated", cn)
+	}
+
+	// 写坏的证书不替换当前证书
+	if err := os.WriteFile(certFile, []byte("garbage"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(2 * time.Second)
+	_ = os.Chtimes(certFile, later, later)
+	files.checkedAt = time.Time{}
+	if cn := commonName(); cn != "rotated" {
+		t.Fatalf("broken file replaced cert: cn=%s", cn)
+	}
+}
+
+func TestConfig_TLSValidation(t *testing.T) {
+	base := Config{Endpoint: "x:1", AppName: "a", AppKey: "k", AppSecret: "s"}
+	cfg := base
+	cfg.TLSCertFile = "client.pem"
+	cfg.applyDefaults()
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("cert without key must be rejected")
+	}
+	cfg = base
+	cfg.TLSEnabled = true
+	cfg.TransportCredentials = credentials.NewTLS(nil)
+	cfg.applyDefaults()
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("TransportCredentials with TLS options must be rejected")
+	}
+}
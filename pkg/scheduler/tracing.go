@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/sidchai/compkg/pkg/trace"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// 链路追踪：SubmitTask/EnqueueTask 从调用方 ctx 取 trace_id/span_id 写入请求，
+// scheduler 原样带到 Dispatch；onDispatch 以此为 remote parent 开 consumer span，
+// 从而把 "提交方 → scheduler → worker handler" 串成一条 trace。
+
+// attrRetryCount / attrRunStatus run span 上除 trace.AttrJobName / trace.AttrRunID 外的附加 attribute。
+const (
+	attrRetryCount = "retry_count"
+	attrRunStatus  = "run_status"
+)
+
+// fillTraceFromContext 当 opts 未显式指定 TraceID 时，用 ctx 中当前 span 的 trace_id/span_id 填充。
+//
+// 显式传入的 TraceID/SpanID 优先，保持历史调用方的透传语义不变。
+func fillTraceFromContext(ctx context.Context, opts *SubmitOptions) {
+	if opts.TraceID != "" {
+		return
+	}
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	opts.TraceID = sc.TraceID().String()
+	opts.SpanID = sc.SpanID().String()
+}
+
+// remoteSpanContext 把 Dispatch 携带的 hex trace_id/span_id 还原为 remote SpanContext；非法或缺失时返回 invalid。
+//
+// Dispatch 不携带 trace flags：既然提交方带了 trace_id，按已采样处理，避免 ParentBased 采样器把整条链丢掉。
+func remoteSpanContext(traceID, spanID string) oteltrace.SpanContext {
+	tid, err := oteltrace.TraceIDFromHex(traceID)
+	if err != nil {
+		return oteltrace.SpanContext{}
+	}
+	sid, err := oteltrace.SpanIDFromHex(spanID)
+	if err != nil {
+		return oteltrace.SpanContext{}
+	}
+	return oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: oteltrace.FlagsSampled,
+		Remote:     true,
+	})
+}
+
+// startRunSpan 为一次 Dispatch 开 consumer span，remote parent 取自 d.TraceId/d.SpanId。
+//
+// 返回的 ctx 作为 handler ctx，业务在其下 StartSpan 会自动挂到本 span 之下；
+// 调用方在上报 JobResult 前调用 endRunSpan。
+func startRunSpan(ctx context.Context, d *pb.Dispatch) (context.Context, oteltrace.Span) {
+	if sc := remoteSpanContext(d.TraceId, d.SpanId); sc.IsValid() {
+		ctx = oteltrace.ContextWithRemoteSpanContext(ctx, sc)
+	}
+	ctx, span := trace.StartConsumerSpan(ctx, "scheduler.run "+d.JobName)
+	attrs := []attribute.KeyValue{
+		attribute.String(trace.AttrJobName, d.JobName),
+		attribute.String(trace.AttrRunID, d.RunId),
+		attribute.Int(attrRetryCount, int(d.RetryCount)),
+	}
+	switch d.TriggerType {
+	case pb.TriggerType_TRIGGER_TYPE_CRON, pb.TriggerType_TRIGGER_TYPE_FIXED_RATE:
+		attrs = append(attrs, attribute.String(trace.AttrTraceOrigin, trace.OriginSchedulerCron))
+	case pb.TriggerType_TRIGGER_TYPE_MANUAL:
+		attrs = append(attrs, attribute.String(trace.AttrTraceOrigin, trace.OriginSchedulerManual))
+	}
+	trace.SetAttributes(span, attrs...)
+	return ctx, span
+}
+
+// endRunSpan 把最终 RunStatus 写入 span（SUCCESS → Ok，其余 → Error）并结束 span。
+func endRunSpan(span oteltrace.Span, status pb.RunStatus, err error) {
+	trace.SetAttributes(span, attribute.String(attrRunStatus, status.String()))
+	if status == pb.RunStatus_RUN_STATUS_SUCCESS {
+		span.SetStatus(codes.Ok, "")
+	} else {
+		trace.RecordError(span, err)
+		span.SetStatus(codes.Error, status.String())
+	}
+	span.End()
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestFillTraceFromContext(t *testing.T) {
+	sc := remoteSpanContext("4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7")
+	ctx := oteltrace.ContextWithSpanContext(context.Background(), sc)
+
+	opts := SubmitOptions{JobName: "j"}
+	fillTraceFromContext(ctx, &opts)
+	if opts.TraceID != "4bf92f3577b34da6a3ce929d0e0e4736" || opts.SpanID != "00f067aa0ba902b7" {
+		t.Fatalf("trace not filled from ctx: %+v", opts)
+	}
+
+	explicit := SubmitOptions{JobName: "j", TraceID: "explicit"}
+	fillTraceFromContext(ctx, &explicit)
+	if explicit.TraceID != "explicit" || explicit.SpanID != "" {
+		t.Fatalf("explicit TraceID must win: %+v", explicit)
+	}
+
+	empty := SubmitOptions{JobName: "j"}
+	fillTraceFromContext(context.Background(), &empty)
+	if empty.TraceID != "" {
+		t.Fatalf("no span in ctx should leave TraceID empty: %+v", empty)
+	}
+}
+
+func TestStartRunSpan_RemoteParentAndStatus(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.NeverSample())), sdktrace.WithSpanProcessor(rec))
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = tp.Shutdown(context.Background())
+	})
+
+	d := &pb.Dispatch{
+		RunId:      "run-1",
+		JobName:    "export",
+		RetryCount: 2,
+		TraceId:    "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanId:     "00f067aa0ba902b7",
+	}
+	ctx, span := startRunSpan(context.Background(), d)
+	if got := oteltrace.SpanContextFromContext(ctx).TraceID().String(); got != d.TraceId {
+		t.Fatalf("handler ctx trace_id got=%s want=%s", got, d.TraceId)
+	}
+	endRunSpan(span, pb.RunStatus_RUN_STATUS_FAILED, errors.New("boom"))
+
+	ended := rec.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expect 1 ended span, got %d", len(ended))
+	}
+	s := ended[0]
+	if s.SpanKind() != oteltrace.SpanKindConsumer {
+		t.Fatalf("span kind got=%s", s.SpanKind())
+	}
+	if s.Parent().SpanID().String() != d.SpanId || !s.Parent().IsRemote() {
+		t.Fatalf("span parent got=%s remote=%v", s.Parent().SpanID(), s.Parent().IsRemote())
+	}
+	if s.Status().Code != codes.Error {
+		t.Fatalf("span status got=%v", s.Status())
+	}
+	attrs := map[string]string{}
+	for _, kv := range s.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	if attrs["job_name"] != "export" || attrs["run_id"] != "run-1" || attrs["retry_count"] != "2" ||
+		attrs["run_status"] != "RUN_STATUS_FAILED" {
+		t.Fatalf("unexpected span attributes: %v", attrs)
+	}
+}
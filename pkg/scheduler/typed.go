@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sidchai/compkg/pkg/serialization"
+	// 注册 json / sonic 两种 codec，供 Config.PayloadCodec 按名查找
+	_ "github.com/sidchai/compkg/pkg/serialization/impl"
+)
+
+// 内置 payload codec 名称，与 pkg/serialization/impl 的注册名一致。
+const (
+	CodecJSON  = "json"
+	CodecSonic = "sonic"
+)
+
+// ErrPayloadDecode typed handler 反序列化 Job.Payload 失败。
+//
+// 该错误属于"数据本身不合法"，重试不会成功：SDK 与 Permanent 一样上报 RUN_STATUS_FAILED + non_retriable=true，
+// 由服务端直接转入死信，而不是按 retry_max 反复重试。可用 errors.Is 判定。
+var ErrPayloadDecode = errors.New("scheduler: decode job payload")
+
+// TypedHandlerFunc 是 RegisterTypedHandler 的业务函数：入参已按 codec 解码，返回值由 SDK 编码为 JobResult.output。
+type TypedHandlerFunc[In, Out any] func(ctx context.Context, job *Job, in In) (Out, error)
+
+// RegisterTypedHandler 注册一个强类型 handler，省去每个 handler 重复的 Unmarshal/Marshal 样板代码。
+//
+// 编解码使用 Config.PayloadCodec 指定的 pkg/serialization 实现（默认 json）：
+//   - Job.Payload 为空 → in 为零值，不做解码；转存到对象存储的 payload 先经 Job.LoadPayload 取回
+//   - 解码失败 → 返回包装 ErrPayloadDecode 的错误，与 Permanent 一样上报 RUN_STATUS_FAILED + non_retriable，不触发重试
+//   - fn 返回 (out, nil) → out 编码后作为 JobResult.output
+//   - fn 返回 (_, err) → 与 HandlerFunc 语义一致
+//
+// Go 不支持泛型方法，因此以包级函数形式提供；与 RegisterHandler 一样必须在 Start 之前调用，
+// opts 同 RegisterHandler（per-job middleware 与并发选项）。
+func RegisterTypedHandler[In, Out any](c *Client, jobName string, fn TypedHandlerFunc[In, Out], opts ...HandlerOption) {
+	if fn == nil {
+		panic("scheduler: RegisterTypedHandler with nil handler")
+	}
+	codec := c.payloadCodec()
+	c.RegisterHandler(jobName, func(ctx context.Context, job *Job) (string, error) {
+		var in In
+		payload, err := job.LoadPayload(ctx)
+		if err != nil {
+			return "", err
+		}
+		if len(payload) > 0 {
+			if err := codec.Unmarshal(payload, &in); err != nil {
+				return "", fmt.Errorf("%w: job=%s: %v", ErrPayloadDecode, job.JobName, err)
+			}
+		}
+		out, err := fn(ctx, job, in)
+		if err != nil {
+			return "", err
+		}
+		data, err := codec.Marshal(out)
+		if err != nil {
+			return "", fmt.Errorf("scheduler: encode job output job=%s: %w", job.JobName, err)
+		}
+		return string(data), nil
+	}, opts...)
+}
+
+// SubmitTyped 把 in 按 Config.PayloadCodec 编码后写入 opts.Payload，再调用 SubmitTask。
+//
+// opts.Payload 若已有值会被覆盖；其余字段（JobName/BizKey/DedupeWindowSec 等）原样透传。
+func SubmitTyped[In any](ctx context.Context, c *Client, opts SubmitOptions, in In) (runID string, dedup bool, err error) {
+	data, err := c.payloadCodec().Marshal(in)
+	if err != nil {
+		return "", false, fmt.Errorf("scheduler: encode submit payload job=%s: %w", opts.JobName, err)
+	}
+	opts.Payload = data
+	return c.SubmitTask(ctx, opts)
+}
+
+// payloadCodec 返回 Config.PayloadCodec 对应的序列化实现；Validate 已保证存在。
+func (c *Client) payloadCodec() serialization.Serialization {
+	return serialization.GetSerialization(c.cfg.PayloadCodec)
+}
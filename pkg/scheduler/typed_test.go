@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+)
+
+type typedIn struct {
+	RecordID int64 `json:"record_id"`
+}
+
+type typedOut struct {
+	OK       bool  `json:"ok"`
+	RecordID int64 `json:"record_id"`
+}
+
+func TestRegisterTypedHandler_DecodeEncode(t *testing.T) {
+	for _, codec := range []string{CodecJSON, CodecSonic} {
+		t.Run(codec, func(t *testing.T) {
+			c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", PayloadCodec: codec})
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+			RegisterTypedHandler(c, "typed", func(_ context.Context, _ *Job, in typedIn) (typedOut, error) {
+				return typedOut{OK: true, RecordID: in.RecordID}, nil
+			})
+			h := c.lookupHandler("typed")
+			if h == nil {
+				t.Fatal("typed handler not registered")
+			}
+			out, err := h(context.Background(), &Job{JobName: "typed", Payload: []byte(`{"record_id":42}`)})
+			if err != nil {
+				t.Fatalf("handler err: %v", err)
+			}
+			if out != `{"ok":true,"record_id":42}` {
+				t.Fatalf("output got=%s", out)
+			}
+		})
+	}
+}
+
+func TestRegisterTypedHandler_EmptyPayloadZeroValue(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	var got typedIn
+	RegisterTypedHandler(c, "typed", func(_ context.Context, _ *Job, in typedIn) (struct{}, error) {
+		got = in
+		return struct{}{}, nil
+	})
+	if _, err := c.lookupHandler("typed")(context.Background(), &Job{JobName: "typed"}); err != nil {
+		t.Fatalf("handler err: %v", err)
+	}
+	if got.RecordID != 0 {
+		t.Fatalf("expect zero value input, got %+v", got)
+	}
+}
+
+func TestRegisterTypedHandler_DecodeFailureIsPayloadDecode(t *testing.T) {
+	c, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	called := false
+	RegisterTypedHandler(c, "typed", func(_ context.Context, _ *Job, _ typedIn) (typedOut, error) {
+		called = true
+		return typedOut{}, nil
+	})
+	_, err = c.lookupHandler("typed")(context.Background(), &Job{JobName: "typed", Payload: []byte(`not-json`)})
+	if !errors.Is(err, ErrPayloadDecode) {
+		t.Fatalf("expect ErrPayloadDecode, got %v", err)
+	}
+	if called {
+		t.Fatal("business fn must not run when payload decode fails")
+	}
+	// 与 Permanent 一致：FAILED + non_retriable，由服务端转 DEAD
+	res := &pb.JobResult{Status: runStatusOf(err)}
+	applyHandlerError(res, err)
+	if res.Status != pb.RunStatus_RUN_STATUS_FAILED || !res.NonRetriable {
+		t.Fatalf("status=%s non_retriable=%v, want FAILED + non_retriable", res.Status, res.NonRetriable)
+	}
+}
+
+func TestConfig_ValidateUnknownPayloadCodec(t *testing.T) {
+	_, err := New(Config{Endpoint: "x:9090", AppName: "a", AppKey: "k", AppSecret: "s", PayloadCodec: "xml"})
+	if err == nil {
+		t.Fatal("expect error for unknown PayloadCodec")
+	}
+}
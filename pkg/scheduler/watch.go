@@ -0,0 +1,232 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sidchai/compkg/pkg/logger"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrRunFailed SubmitAndWait 等待的 run 以 SUCCESS 以外的状态结束；错误信息含最终状态与 Run.error。
+var ErrRunFailed = errors.New("scheduler: run failed")
+
+// WatchRun 轮询降级时的间隔：无变化时从 min 逐次 ×1.5 退避到 max，观察到变化后回到 min。
+const (
+	watchPollMin = 200 * time.Millisecond
+	watchPollMax = 5 * time.Second
+)
+
+// RunEvent WatchRun 推送的一次 run 变化。
+type RunEvent struct {
+	// Run 变化后的快照；Err 非 nil 时为 nil
+	Run *pb.Run
+	// Err watch 异常结束（ctx 取消、run 不存在、鉴权失败等）；非 nil 时为最后一个事件
+	Err error
+}
+
+// Finished 该事件的 run 已结束，之后 channel 关闭。
+func (e RunEvent) Finished() bool {
+	return e.Run != nil && runFinished(e.Run)
+}
+
+// WatchRun 订阅 run 的状态与进度变化：先推送当前快照，之后每次变化推送一次；run 结束或出错后关闭 channel。
+//
+// 优先使用服务端流式 WatchRun；服务端未实现（codes.Unimplemented）或流中途断开时降级为自适应轮询 GetRun
+// （200ms 起、无变化时退避到 5s）。调用方不再消费时必须取消 ctx，否则后台 goroutine 阻塞在发送上。
+//
+//	for ev := range c.WatchRun(ctx, runID) {
+//	    if ev.Err != nil { return ev.Err }
+//	    fmt.Printf("%s %d%% %s\n", ev.Run.Status, ev.Run.ProgressPercent, ev.Run.ProgressMessage)
+//	}
+func (c *Client) WatchRun(ctx context.Context, runID string) <-chan RunEvent {
+	out := make(chan RunEvent, 1)
+	go func() {
+		defer close(out)
+		w := &runWatcher{c: c, runID: runID, out: out}
+		if err := w.watch(ctx); err != nil {
+			select {
+			case out <- RunEvent{Err: err}:
+			case <-ctx.Done():
+				// 调用方已取消、可能不再消费：只在有缓冲空间时投递
+				select {
+				case out <- RunEvent{Err: err}:
+				default:
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// WaitRun 阻塞直到 run 结束，返回最终快照；run 失败不视为错误，由调用方检查 Run.Status。
+func (c *Client) WaitRun(ctx context.Context, runID string) (*pb.Run, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for ev := range c.WatchRun(ctx, runID) {
+		if ev.Err != nil {
+			return nil, ev.Err
+		}
+		if ev.Finished() {
+			return ev.Run, nil
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("scheduler: watch run %s ended unexpectedly", runID)
+}
+
+// SubmitAndWait 提交任务并等待结束，把 Run.output 按 Config.PayloadCodec 解码为 Out。
+//
+// 用于同步管理接口、测试等需要拿到执行结果的场景；run 以 SUCCESS 以外的状态结束时返回包装 ErrRunFailed 的错误，
+// 同时返回最终 run 便于查看 error / output。ctx 同时约束提交与等待，应设置合理的超时。
+// Out 为 string 时不解码，直接返回原始 output。
+func SubmitAndWait[Out any](ctx context.Context, c *Client, opts SubmitOptions) (Out, *pb.Run, error) {
+	var out Out
+	runID, _, err := c.SubmitTask(ctx, opts)
+	if err != nil {
+		return out, nil, err
+	}
+	run, err := c.WaitRun(ctx, runID)
+	if err != nil {
+		return out, nil, err
+	}
+	if run.Status != pb.RunStatus_RUN_STATUS_SUCCESS {
+		return out, run, fmt.Errorf("%w: run %s %s: %s", ErrRunFailed, runID, run.Status, run.Error)
+	}
+	output, err := c.ResolvePayload(ctx, run.Output)
+	if err != nil {
+		return out, run, err
+	}
+	if s, ok := any(&out).(*string); ok {
+		*s = string(output)
+		return out, run, nil
+	}
+	if len(output) > 0 {
+		if err := c.payloadCodec().Unmarshal(output, &out); err != nil {
+			return out, run, fmt.Errorf("scheduler: decode run output run_id=%s: %w", runID, err)
+		}
+	}
+	return out, run, nil
+}
+
+// runFinished run 是否已结束：SUCCESS / DEAD / CANCELED，或 FAILED / TIMEOUT / DISPATCH_FAIL 且没有待执行的重试。
+func runFinished(run *pb.Run) bool {
+	switch run.Status {
+	case pb.RunStatus_RUN_STATUS_SUCCESS, pb.RunStatus_RUN_STATUS_DEAD, pb.RunStatus_RUN_STATUS_CANCELED:
+		return true
+	case pb.RunStatus_RUN_STATUS_FAILED, pb.RunStatus_RUN_STATUS_TIMEOUT, pb.RunStatus_RUN_STATUS_DISPATCH_FAIL:
+		return run.NextRunAt == 0
+	default:
+		return false
+	}
+}
+
+// runWatcher 单次 WatchRun 的状态：去重已推送的快照，流式失败后降级轮询。
+type runWatcher struct {
+	c     *Client
+	runID string
+	out   chan<- RunEvent
+	last  *pb.Run
+}
+
+func (w *runWatcher) watch(ctx context.Context) error {
+	if w.c.schedCli == nil {
+		return ErrSubmitNotConnected
+	}
+	if w.runID == "" {
+		return errors.New("scheduler: WatchRun runID required")
+	}
+	done, err := w.stream(ctx)
+	if done || err != nil {
+		return err
+	}
+	return w.poll(ctx)
+}
+
+// stream 经服务端流式 WatchRun 订阅；done=true 表示 run 已结束或调用方已取消。
+// 返回 (false, nil) 表示应降级为轮询。
+func (w *runWatcher) stream(ctx context.Context) (done bool, err error) {
+	if w.c.watchUnsupported.Load() {
+		return false, nil
+	}
+	stream, err := w.c.schedCli.WatchRun(ctx, &pb.WatchRunRequest{RunId: w.runID})
+	for err == nil {
+		var ev *pb.RunEvent
+		if ev, err = stream.Recv(); err != nil {
+			break
+		}
+		if ev.Run == nil {
+			continue
+		}
+		if done, err := w.emit(ctx, ev.Run); done || err != nil {
+			return true, err
+		}
+	}
+	if ctx.Err() != nil {
+		return true, ctx.Err()
+	}
+	switch status.Code(err) {
+	case codes.Unimplemented:
+		// 老版本服务端：本 Client 之后的 WatchRun 直接轮询
+		w.c.watchUnsupported.Store(true)
+		return false, nil
+	case codes.NotFound, codes.PermissionDenied, codes.Unauthenticated, codes.InvalidArgument:
+		return true, adminErr("watch run "+w.runID, err)
+	}
+	if !errors.Is(err, io.EOF) {
+		logger.Warnf("[scheduler-sdk] watch run_id=%s stream err=%v, fallback to polling", w.runID, err)
+	}
+	return false, nil
+}
+
+// poll 自适应轮询 GetRun 直到 run 结束。
+func (w *runWatcher) poll(ctx context.Context) error {
+	interval := watchPollMin
+	for {
+		run, err := w.c.GetRun(ctx, w.runID)
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case err != nil && status.Code(err) == codes.Unavailable:
+			// 瞬时不可用：继续轮询
+		case err != nil:
+			return adminErr("watch run "+w.runID, err)
+		default:
+			changed := w.last == nil || !proto.Equal(w.last, run)
+			if done, err := w.emit(ctx, run); done || err != nil {
+				return err
+			}
+			if changed {
+				interval = watchPollMin
+			}
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		interval = min(interval*3/2, watchPollMax)
+	}
+}
+
+// emit 推送与上次不同的快照；done=true 表示 run 已结束。
+func (w *runWatcher) emit(ctx context.Context, run *pb.Run) (done bool, err error) {
+	if w.last != nil && proto.Equal(w.last, run) {
+		return false, nil
+	}
+	w.last = run
+	select {
+	case w.out <- RunEvent{Run: run}:
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+	return runFinished(run), nil
+}
@@ -0,0 +1,104 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sidchai/compkg/pkg/scheduler/schedulertest"
+	pb "github.com/sidchai/compkg/proto/scheduler/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRunFinished(t *testing.T) {
+	tests := []struct {
+		run  *pb.Run
+		want bool
+	}{
+		{&pb.Run{Status: pb.RunStatus_RUN_STATUS_RUNNING}, false},
+		{&pb.Run{Status: pb.RunStatus_RUN_STATUS_SUCCESS}, true},
+		{&pb.Run{Status: pb.RunStatus_RUN_STATUS_DEAD}, true},
+		{&pb.Run{Status: pb.RunStatus_RUN_STATUS_FAILED}, true},
+		{&pb.Run{Status: pb.RunStatus_RUN_STATUS_FAILED, NextRunAt: 1}, false},
+		{&pb.Run{Status: pb.RunStatus_RUN_STATUS_TIMEOUT, NextRunAt: 1}, false},
+	}
+	for _, tt := range tests {
+		if got := runFinished(tt.run); got != tt.want {
+			t.Errorf("runFinished(%s next=%d)=%v, want %v", tt.run.Status, tt.run.NextRunAt, got, tt.want)
+		}
+	}
+}
+
+func TestIntegration_SubmitAndWait(t *testing.T) {
+	_, c := startFakeClientWith(t, schedulertest.Options{AutoDispatch: true}, nil, func(c *Client) {
+		RegisterTypedHandler(c, "lookup", func(_ context.Context, _ *Job, in typedIn) (typedOut, error) {
+			if in.RecordID < 0 {
+				return typedOut{}, Permanent(errors.New("negative record"))
+			}
+			return typedOut{OK: true, RecordID: in.RecordID}, nil
+		})
+	})
+	ctx := waitCtx(t)
+
+	out, run, err := SubmitAndWait[typedOut](ctx, c, SubmitOptions{JobName: "lookup", Payload: []byte(`{"record_id":7}`)})
+	if err != nil || !out.OK || out.RecordID != 7 || run.Status != pb.RunStatus_RUN_STATUS_SUCCESS {
+		t.Fatalf("out=%+v run=%v err=%v", out, run, err)
+	}
+	raw, _, err := SubmitAndWait[string](ctx, c, SubmitOptions{JobName: "lookup", Payload: []byte(`{"record_id":8}`)})
+	if err != nil || raw != `{"ok":true,"record_id":8}` {
+		t.Fatalf("raw=%q err=%v", raw, err)
+	}
+
+	_, run, err = SubmitAndWait[typedOut](ctx, c, SubmitOptions{JobName: "lookup", Payload: []byte(`{"record_id":-1}`)})
+	if !errors.Is(err, ErrRunFailed) || run == nil || run.Status != pb.RunStatus_RUN_STATUS_DEAD {
+		t.Fatalf("failed run=%v err=%v", run, err)
+	}
+
+	if _, err := c.WaitRun(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("WaitRun unknown err=%v, want ErrNotFound", err)
+	}
+}
+
+func TestIntegration_WatchRunPollingFallback(t *testing.T) {
+	release := make(chan struct{})
+	srv, c := startFakeClient(t, schedulertest.Options{DisableWatchRun: true}, nil, map[string]HandlerFunc{
+		"export": func(ctx context.Context, _ *Job) (string, error) {
+			_ = ReportProgress(ctx, 40, "rows 400/1000")
+			<-release
+			return "done", nil
+		},
+	})
+	ctx := waitCtx(t)
+	if err := srv.Dispatch(&pb.Dispatch{RunId: "r1", JobName: "export"}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+
+	var sawProgress bool
+	var last RunEvent
+	for ev := range c.WatchRun(ctx, "r1") {
+		if ev.Err != nil {
+			t.Fatalf("watch err: %v", ev.Err)
+		}
+		if ev.Run.ProgressPercent == 40 && !sawProgress {
+			sawProgress = true
+			close(release)
+		}
+		last = ev
+	}
+	if !sawProgress || !last.Finished() || last.Run.Status != pb.RunStatus_RUN_STATUS_SUCCESS || string(last.Run.Output) != "done" {
+		t.Fatalf("sawProgress=%v last=%v", sawProgress, last.Run)
+	}
+	if !c.watchUnsupported.Load() {
+		t.Fatal("Unimplemented WatchRun should switch the client to polling")
+	}
+
+	// 轮询路径的错误保留原始 error 链：gRPC status 与非 gRPC 错误都不能丢
+	if _, err := c.WaitRun(ctx, "missing"); !errors.Is(err, ErrNotFound) || status.Code(err) != codes.NotFound {
+		t.Fatalf("polling WaitRun unknown err=%v, want ErrNotFound", err)
+	}
+	w := &runWatcher{c: &Client{}, runID: "r1"}
+	if err := w.poll(ctx); !errors.Is(err, ErrSubmitNotConnected) {
+		t.Fatalf("poll err=%v, want ErrSubmitNotConnected", err)
+	}
+}
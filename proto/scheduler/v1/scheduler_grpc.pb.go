@@ -182,8 +182,10 @@ var WorkerService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	SchedulerService_SubmitTask_FullMethodName           = "/scheduler.v1.SchedulerService/SubmitTask"
+	SchedulerService_SubmitTasks_FullMethodName          = "/scheduler.v1.SchedulerService/SubmitTasks"
 	SchedulerService_GetRun_FullMethodName               = "/scheduler.v1.SchedulerService/GetRun"
 	SchedulerService_CancelRun_FullMethodName            = "/scheduler.v1.SchedulerService/CancelRun"
+	SchedulerService_WatchRun_FullMethodName             = "/scheduler.v1.SchedulerService/WatchRun"
 	SchedulerService_ListJobs_FullMethodName             = "/scheduler.v1.SchedulerService/ListJobs"
 	SchedulerService_GetJob_FullMethodName               = "/scheduler.v1.SchedulerService/GetJob"
 	SchedulerService_CreateJob_FullMethodName            = "/scheduler.v1.SchedulerService/CreateJob"
@@ -209,10 +211,15 @@ type SchedulerServiceClient interface {
 	// 提交一次 API 任务（trigger_type = api）
 	// 注：业务方主线建议走 gRPC + HMAC；REST 端点仅供调试/低频场景
 	SubmitTask(ctx context.Context, in *SubmitTaskRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
+	// 批量提交 API 任务：一次签名、一次往返；逐条返回 run_id / dedup / 错误，单条失败不影响其他条目
+	SubmitTasks(ctx context.Context, in *SubmitTasksRequest, opts ...grpc.CallOption) (*SubmitTasksResponse, error)
 	// 查询 run 状态
 	GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*Run, error)
 	// 取消未完成的 run
 	CancelRun(ctx context.Context, in *CancelRunRequest, opts ...grpc.CallOption) (*CancelRunResponse, error)
+	// 订阅 run 变化：先推送当前快照，之后每次状态 / 进度变化推送一次；
+	// run 结束（SUCCESS / DEAD / CANCELED，或失败且没有待执行的重试）后服务端关闭流
+	WatchRun(ctx context.Context, in *WatchRunRequest, opts ...grpc.CallOption) (SchedulerService_WatchRunClient, error)
 	// 任务管理
 	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
 	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
@@ -254,6 +261,16 @@ func (c *schedulerServiceClient) SubmitTask(ctx context.Context, in *SubmitTaskR
 	return out, nil
 }
 
+func (c *schedulerServiceClient) SubmitTasks(ctx context.Context, in *SubmitTasksRequest, opts ...grpc.CallOption) (*SubmitTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitTasksResponse)
+	err := c.cc.Invoke(ctx, SchedulerService_SubmitTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *schedulerServiceClient) GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*Run, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Run)
@@ -274,6 +291,39 @@ func (c *schedulerServiceClient) CancelRun(ctx context.Context, in *CancelRunReq
 	return out, nil
 }
 
+func (c *schedulerServiceClient) WatchRun(ctx context.Context, in *WatchRunRequest, opts ...grpc.CallOption) (SchedulerService_WatchRunClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SchedulerService_ServiceDesc.Streams[0], SchedulerService_WatchRun_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schedulerServiceWatchRunClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SchedulerService_WatchRunClient interface {
+	Recv() (*RunEvent, error)
+	grpc.ClientStream
+}
+
+type schedulerServiceWatchRunClient struct {
+	grpc.ClientStream
+}
+
+func (x *schedulerServiceWatchRunClient) Recv() (*RunEvent, error) {
+	m := new(RunEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *schedulerServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListJobsResponse)
@@ -441,10 +491,15 @@ type SchedulerServiceServer interface {
 	// 提交一次 API 任务（trigger_type = api）
 	// 注：业务方主线建议走 gRPC + HMAC；REST 端点仅供调试/低频场景
 	SubmitTask(context.Context, *SubmitTaskRequest) (*SubmitTaskResponse, error)
+	// 批量提交 API 任务：一次签名、一次往返；逐条返回 run_id / dedup / 错误，单条失败不影响其他条目
+	SubmitTasks(context.Context, *SubmitTasksRequest) (*SubmitTasksResponse, error)
 	// 查询 run 状态
 	GetRun(context.Context, *GetRunRequest) (*Run, error)
 	// 取消未完成的 run
 	CancelRun(context.Context, *CancelRunRequest) (*CancelRunResponse, error)
+	// 订阅 run 变化：先推送当前快照，之后每次状态 / 进度变化推送一次；
+	// run 结束（SUCCESS / DEAD / CANCELED，或失败且没有待执行的重试）后服务端关闭流
+	WatchRun(*WatchRunRequest, SchedulerService_WatchRunServer) error
 	// 任务管理
 	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 	GetJob(context.Context, *GetJobRequest) (*Job, error)
@@ -476,12 +531,18 @@ type UnimplementedSchedulerServiceServer struct {
 func (UnimplementedSchedulerServiceServer) SubmitTask(context.Context, *SubmitTaskRequest) (*SubmitTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitTask not implemented")
 }
+func (UnimplementedSchedulerServiceServer) SubmitTasks(context.Context, *SubmitTasksRequest) (*SubmitTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTasks not implemented")
+}
 func (UnimplementedSchedulerServiceServer) GetRun(context.Context, *GetRunRequest) (*Run, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRun not implemented")
 }
 func (UnimplementedSchedulerServiceServer) CancelRun(context.Context, *CancelRunRequest) (*CancelRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRun not implemented")
 }
+func (UnimplementedSchedulerServiceServer) WatchRun(*WatchRunRequest, SchedulerService_WatchRunServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRun not implemented")
+}
 func (UnimplementedSchedulerServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
 }
@@ -561,6 +622,24 @@ func _SchedulerService_SubmitTask_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulerService_SubmitTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServiceServer).SubmitTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SchedulerService_SubmitTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServiceServer).SubmitTasks(ctx, req.(*SubmitTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SchedulerService_GetRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRunRequest)
 	if err := dec(in); err != nil {
@@ -597,6 +676,27 @@ func _SchedulerService_CancelRun_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SchedulerService_WatchRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRunRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchedulerServiceServer).WatchRun(m, &schedulerServiceWatchRunServer{ServerStream: stream})
+}
+
+type SchedulerService_WatchRunServer interface {
+	Send(*RunEvent) error
+	grpc.ServerStream
+}
+
+type schedulerServiceWatchRunServer struct {
+	grpc.ServerStream
+}
+
+func (x *schedulerServiceWatchRunServer) Send(m *RunEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _SchedulerService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListJobsRequest)
 	if err := dec(in); err != nil {
@@ -896,6 +996,10 @@ var SchedulerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitTask",
 			Handler:    _SchedulerService_SubmitTask_Handler,
 		},
+		{
+			MethodName: "SubmitTasks",
+			Handler:    _SchedulerService_SubmitTasks_Handler,
+		},
 		{
 			MethodName: "GetRun",
 			Handler:    _SchedulerService_GetRun_Handler,
@@ -969,7 +1073,13 @@ var SchedulerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _SchedulerService_RejectPendingChange_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRun",
+			Handler:       _SchedulerService_WatchRun_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "scheduler/v1/scheduler.proto",
 }
 